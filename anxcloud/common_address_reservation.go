@@ -0,0 +1,131 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/utils"
+	"go.anx.io/go-anxcloud/pkg/ipam/address"
+)
+
+// addressReservationBatchWait is the time random address reservations with the same
+// parameters are collected before a single reservation is sent to the API
+const addressReservationBatchWait = 2 * time.Second
+
+// addressReleaseTimeout limits releasing addresses reserved for callers which are gone already
+const addressReleaseTimeout = time.Minute
+
+type reservedAddress struct {
+	ID      string
+	Address string
+}
+
+// reserveRandomAddresses reserves def.Count random addresses. Concurrent reservations with otherwise
+// identical parameters are aggregated into a single ReserveRandom request.
+func reserveRandomAddresses(ctx context.Context, pc providerContext, def address.ReserveRandom) ([]reservedAddress, error) {
+	return addressReserverFor(pc, def).Process(ctx, def)
+}
+
+func addressReserverFor(pc providerContext, def address.ReserveRandom) *utils.Batcher[address.ReserveRandom, []reservedAddress] {
+	key := fmt.Sprintf("%s/%s/%s/%d/%d", def.LocationID, def.VlanID, def.PrefixID, def.IPVersion, def.ReservationPeriod)
+	addressClient := address.NewAPI(pc.legacyClient)
+
+	anyBatcher, _ := pc.addressReservers.LoadOrStore(key, &utils.Batcher[address.ReserveRandom, []reservedAddress]{
+		Wait:      addressReservationBatchWait,
+		BatchFunc: addressReservationBatch(addressClient),
+		Discard: func(reserved []reservedAddress) {
+			// the caller is gone, the addresses reserved for it would never be used
+			ctx, cancel := context.WithTimeout(context.Background(), addressReleaseTimeout)
+			defer cancel()
+			releaseReservedAddresses(ctx, addressClient, reserved)
+		},
+	})
+
+	return anyBatcher.(*utils.Batcher[address.ReserveRandom, []reservedAddress])
+}
+
+func addressReservationBatch(addressClient address.API) func(context.Context, []address.ReserveRandom) []utils.BatchUnitResult[[]reservedAddress] {
+	return func(ctx context.Context, units []address.ReserveRandom) []utils.BatchUnitResult[[]reservedAddress] {
+		// all units of a batch share the same parameters, only the count differs
+		def := units[0]
+		def.Count = 0
+		for _, unit := range units {
+			def.Count += unit.Count
+		}
+
+		reserved, err := reserveAddresses(ctx, addressClient, def)
+		if err == nil || len(units) == 1 {
+			return distributeAndRelease(ctx, addressClient, units, reserved, err)
+		}
+
+		// the batch might have failed because of its size, e.g. with a conflict,
+		// therefore each unit is reserved on its own before reporting an error
+		res := make([]utils.BatchUnitResult[[]reservedAddress], len(units))
+		for i, unit := range units {
+			reserved, err := reserveAddresses(ctx, addressClient, unit)
+			res[i] = distributeAndRelease(ctx, addressClient, units[i:i+1], reserved, err)[0]
+		}
+
+		return res
+	}
+}
+
+func reserveAddresses(ctx context.Context, addressClient address.API, def address.ReserveRandom) ([]reservedAddress, error) {
+	summary, err := addressClient.ReserveRandom(ctx, def)
+	if err != nil {
+		return nil, err
+	}
+
+	reserved := make([]reservedAddress, 0, len(summary.Data))
+	for _, addr := range summary.Data {
+		reserved = append(reserved, reservedAddress{ID: addr.ID, Address: addr.Address})
+	}
+
+	return reserved, nil
+}
+
+// distributeAndRelease hands out the reserved addresses to the units, or returns err for all of
+// them. Addresses which couldn't be handed out are released again.
+func distributeAndRelease(ctx context.Context, addressClient address.API, units []address.ReserveRandom, reserved []reservedAddress, err error) []utils.BatchUnitResult[[]reservedAddress] {
+	if err != nil {
+		res := make([]utils.BatchUnitResult[[]reservedAddress], len(units))
+		for i := range res {
+			res[i].Error = err
+		}
+		return res
+	}
+
+	res, leftover := distributeReservedAddresses(units, reserved)
+	releaseReservedAddresses(ctx, addressClient, leftover)
+
+	return res
+}
+
+// distributeReservedAddresses hands out the reserved addresses to the units in order. Units which
+// can't be fully satisfied get an error instead, the addresses not handed out are returned as leftover.
+func distributeReservedAddresses(units []address.ReserveRandom, reserved []reservedAddress) ([]utils.BatchUnitResult[[]reservedAddress], []reservedAddress) {
+	res := make([]utils.BatchUnitResult[[]reservedAddress], len(units))
+
+	for i, unit := range units {
+		if len(reserved) < unit.Count {
+			res[i].Error = fmt.Errorf("not enough addresses were reserved, requested %d but only %d left", unit.Count, len(reserved))
+			continue
+		}
+
+		res[i].Data = reserved[:unit.Count]
+		reserved = reserved[unit.Count:]
+	}
+
+	return res, reserved
+}
+
+// releaseReservedAddresses deletes reserved addresses which won't be used, on a best effort basis
+func releaseReservedAddresses(ctx context.Context, addressClient address.API, reserved []reservedAddress) {
+	for _, addr := range reserved {
+		if err := addressClient.Delete(ctx, addr.ID); err != nil {
+			log.Printf("[WARN] failed releasing reserved address %q (%s): %s", addr.Address, addr.ID, err)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package anxcloud
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.anx.io/go-anxcloud/pkg/ipam/address"
+)
+
+func TestDistributeReservedAddresses(t *testing.T) {
+	reserved := []reservedAddress{
+		{ID: "1", Address: "10.0.0.1"},
+		{ID: "2", Address: "10.0.0.2"},
+		{ID: "3", Address: "10.0.0.3"},
+	}
+
+	res, leftover := distributeReservedAddresses([]address.ReserveRandom{{Count: 1}, {Count: 2}, {Count: 1}}, reserved)
+
+	if diff := cmp.Diff(reserved[:1], res[0].Data); diff != "" || res[0].Error != nil {
+		t.Errorf("unexpected result for first unit (-expected +actual):\n%s\nerror: %v", diff, res[0].Error)
+	}
+	if diff := cmp.Diff(reserved[1:], res[1].Data); diff != "" || res[1].Error != nil {
+		t.Errorf("unexpected result for second unit (-expected +actual):\n%s\nerror: %v", diff, res[1].Error)
+	}
+	if res[2].Error == nil {
+		t.Errorf("expected error for third unit, got %v", res[2].Data)
+	}
+	if len(leftover) != 0 {
+		t.Errorf("expected all addresses to be handed out, got leftover %v", leftover)
+	}
+
+	res, leftover = distributeReservedAddresses([]address.ReserveRandom{{Count: 2}, {Count: 2}}, reserved)
+
+	if res[1].Error == nil {
+		t.Errorf("expected error for second unit, got %v", res[1].Data)
+	}
+	if diff := cmp.Diff(reserved[2:], leftover); diff != "" {
+		t.Errorf("expected the address not handed out to be left over (-expected +actual):\n%s", diff)
+	}
+}
@@ -0,0 +1,159 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	e5ev1internal "github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/apis/e5e/v1"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+// e5eCatalog holds the runtimes and worker types available on the e5e platform. It is
+// loaded once per provider instance, failed loads are retried on next use.
+type e5eCatalog struct {
+	mu          sync.Mutex
+	loaded      bool
+	runtimes    []e5ev1internal.E5ERuntime
+	workerTypes []e5ev1internal.E5EWorkerType
+}
+
+func (c *e5eCatalog) load(ctx context.Context, a api.API) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.loaded {
+		return nil
+	}
+
+	runtimes, err := listE5ERuntimes(ctx, a)
+	if err != nil {
+		return fmt.Errorf("failed listing runtimes: %w", err)
+	}
+
+	workerTypes, err := listE5EWorkerTypes(ctx, a)
+	if err != nil {
+		return fmt.Errorf("failed listing worker types: %w", err)
+	}
+
+	c.runtimes = runtimes
+	c.workerTypes = workerTypes
+	c.loaded = true
+
+	return nil
+}
+
+// Runtimes returns all available runtimes, sorted by identifier
+func (c *e5eCatalog) Runtimes(ctx context.Context, a api.API) ([]e5ev1internal.E5ERuntime, error) {
+	if err := c.load(ctx, a); err != nil {
+		return nil, err
+	}
+	return c.runtimes, nil
+}
+
+// WorkerTypes returns all available worker types, sorted by identifier
+func (c *e5eCatalog) WorkerTypes(ctx context.Context, a api.API) ([]e5ev1internal.E5EWorkerType, error) {
+	if err := c.load(ctx, a); err != nil {
+		return nil, err
+	}
+	return c.workerTypes, nil
+}
+
+func listE5ERuntimes(ctx context.Context, a api.API) ([]e5ev1internal.E5ERuntime, error) {
+	var pageIter types.PageInfo
+	if err := a.List(ctx, &e5ev1internal.E5ERuntime{}, api.Paged(1, 100, &pageIter)); err != nil {
+		return nil, err
+	}
+
+	runtimes := make([]e5ev1internal.E5ERuntime, 0, pageIter.TotalItems())
+	var pagedRuntimes []e5ev1internal.E5ERuntime
+	for pageIter.Next(&pagedRuntimes) {
+		runtimes = append(runtimes, pagedRuntimes...)
+	}
+
+	if err := pageIter.Error(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(runtimes, func(i, j int) bool {
+		return runtimes[i].Identifier < runtimes[j].Identifier
+	})
+
+	return runtimes, nil
+}
+
+func listE5EWorkerTypes(ctx context.Context, a api.API) ([]e5ev1internal.E5EWorkerType, error) {
+	var pageIter types.PageInfo
+	if err := a.List(ctx, &e5ev1internal.E5EWorkerType{}, api.Paged(1, 100, &pageIter)); err != nil {
+		return nil, err
+	}
+
+	workerTypes := make([]e5ev1internal.E5EWorkerType, 0, pageIter.TotalItems())
+	var pagedWorkerTypes []e5ev1internal.E5EWorkerType
+	for pageIter.Next(&pagedWorkerTypes) {
+		workerTypes = append(workerTypes, pagedWorkerTypes...)
+	}
+
+	if err := pageIter.Error(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(workerTypes, func(i, j int) bool {
+		return workerTypes[i].Identifier < workerTypes[j].Identifier
+	})
+
+	return workerTypes, nil
+}
+
+// validateE5EFunctionCatalog checks runtime, worker type and quotas of a function against the catalog.
+// An empty worker type or a quota of zero is not validated, as the API chooses defaults for them.
+func validateE5EFunctionCatalog(runtimes []e5ev1internal.E5ERuntime, workerTypes []e5ev1internal.E5EWorkerType, runtime, workerType string, quotaMemory, quotaCPU, quotaTimeout int) error {
+	runtimeIdentifiers := make([]string, 0, len(runtimes))
+	runtimeFound := false
+	for _, r := range runtimes {
+		runtimeIdentifiers = append(runtimeIdentifiers, r.Identifier)
+		runtimeFound = runtimeFound || r.Identifier == runtime
+	}
+
+	if !runtimeFound {
+		return fmt.Errorf("runtime %q is not available, expected one of: %s", runtime, strings.Join(runtimeIdentifiers, ", "))
+	}
+
+	if workerType == "" {
+		return nil
+	}
+
+	workerTypeIdentifiers := make([]string, 0, len(workerTypes))
+	var found *e5ev1internal.E5EWorkerType
+	for i := range workerTypes {
+		workerTypeIdentifiers = append(workerTypeIdentifiers, workerTypes[i].Identifier)
+		if workerTypes[i].Identifier == workerType {
+			found = &workerTypes[i]
+		}
+	}
+
+	if found == nil {
+		return fmt.Errorf("worker type %q is not available, expected one of: %s", workerType, strings.Join(workerTypeIdentifiers, ", "))
+	}
+
+	quotas := []struct {
+		key   string
+		value int
+		max   int
+	}{
+		{"quota_memory", quotaMemory, found.MaxQuotaMemory},
+		{"quota_cpu", quotaCPU, found.MaxQuotaCPU},
+		{"quota_timeout", quotaTimeout, found.MaxQuotaTimeout},
+	}
+
+	for _, quota := range quotas {
+		if quota.max > 0 && quota.value > quota.max {
+			return fmt.Errorf("%s %d exceeds the maximum of %d for worker type %q", quota.key, quota.value, quota.max, workerType)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package anxcloud
+
+import (
+	"strings"
+	"testing"
+
+	e5ev1internal "github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/apis/e5e/v1"
+)
+
+func TestValidateE5EFunctionCatalog(t *testing.T) {
+	runtimes := []e5ev1internal.E5ERuntime{
+		{Identifier: "go_121"},
+		{Identifier: "python_310"},
+	}
+
+	workerTypes := []e5ev1internal.E5EWorkerType{
+		{Identifier: "standard", MaxQuotaMemory: 512, MaxQuotaCPU: 100, MaxQuotaTimeout: 60},
+		{Identifier: "unlimited"},
+	}
+
+	cases := []struct {
+		Name          string
+		Runtime       string
+		WorkerType    string
+		Quotas        [3]int
+		ExpectedError string
+	}{
+		{"valid", "python_310", "standard", [3]int{512, 100, 60}, ""},
+		{"default worker type", "go_121", "", [3]int{4096, 0, 0}, ""},
+		{"unlimited worker type", "go_121", "unlimited", [3]int{4096, 400, 3600}, ""},
+		{"unknown runtime", "python_3.10", "standard", [3]int{}, `runtime "python_3.10" is not available, expected one of: go_121, python_310`},
+		{"unknown worker type", "python_310", "huge", [3]int{}, `worker type "huge" is not available, expected one of: standard, unlimited`},
+		{"memory exceeded", "python_310", "standard", [3]int{1024, 0, 0}, "quota_memory 1024 exceeds the maximum of 512"},
+		{"cpu exceeded", "python_310", "standard", [3]int{0, 200, 0}, "quota_cpu 200 exceeds the maximum of 100"},
+		{"timeout exceeded", "python_310", "standard", [3]int{0, 0, 120}, "quota_timeout 120 exceeds the maximum of 60"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := validateE5EFunctionCatalog(runtimes, workerTypes, tc.Runtime, tc.WorkerType, tc.Quotas[0], tc.Quotas[1], tc.Quotas[2])
+
+			if tc.ExpectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+				t.Errorf("expected error containing %q, got %v", tc.ExpectedError, err)
+			}
+		})
+	}
+}
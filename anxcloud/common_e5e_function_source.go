@@ -0,0 +1,194 @@
+package anxcloud
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// e5eSourceArchiveModTime is used as modification time of all files in a source archive,
+// so that archives built from the same files are identical
+var e5eSourceArchiveModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+type e5eSourceFile struct {
+	name       string
+	path       string
+	executable bool
+}
+
+// buildE5EFunctionSourceArchive packages all files of dir matching the include and exclude patterns
+// into a zip archive. The returned hash only depends on file names, modes and contents.
+func buildE5EFunctionSourceArchive(dir string, include, exclude []string) ([]byte, string, error) {
+	files, err := listE5EFunctionSourceFiles(dir, include, exclude)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(files) == 0 {
+		return nil, "", fmt.Errorf("no files found in %q", dir)
+	}
+
+	var archive bytes.Buffer
+	w := zip.NewWriter(&archive)
+	hash := sha256.New()
+
+	for _, file := range files {
+		content, err := os.ReadFile(file.path)
+		if err != nil {
+			return nil, "", err
+		}
+
+		header := &zip.FileHeader{
+			Name:     file.name,
+			Method:   zip.Deflate,
+			Modified: e5eSourceArchiveModTime,
+		}
+
+		header.SetMode(0644)
+		if file.executable {
+			header.SetMode(0755)
+		}
+
+		fw, err := w.CreateHeader(header)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if _, err := fw.Write(content); err != nil {
+			return nil, "", err
+		}
+
+		contentHash := sha256.Sum256(content)
+		fmt.Fprintf(hash, "%s\x00%s\x00%x\n", file.name, header.Mode(), contentHash)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return archive.Bytes(), hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// e5eFunctionSourceArchiveContent encodes an archive the way the e5e API expects archive contents
+func e5eFunctionSourceArchiveContent(archive []byte) string {
+	return "data:application/zip;base64," + base64.StdEncoding.EncodeToString(archive)
+}
+
+// e5eFunctionSourceArchiveName returns the default archive name for a source directory
+func e5eFunctionSourceArchiveName(dir string) string {
+	return filepath.Base(filepath.Clean(dir)) + ".zip"
+}
+
+func listE5EFunctionSourceFiles(dir string, include, exclude []string) ([]e5eSourceFile, error) {
+	for _, pattern := range append(append([]string{}, include...), exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+
+	var files []e5eSourceFile
+
+	// WalkDir visits files in lexical order, which keeps the archive deterministic
+	err := filepath.WalkDir(dir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+
+		if rel == "." {
+			return nil
+		}
+
+		name := filepath.ToSlash(rel)
+		excluded := matchAnyE5ESourceGlob(exclude, name)
+
+		if entry.IsDir() {
+			if excluded {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if excluded || (len(include) > 0 && !matchAnyE5ESourceGlob(include, name)) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		files = append(files, e5eSourceFile{
+			name:       name,
+			path:       p,
+			executable: info.Mode()&0111 != 0,
+		})
+
+		return nil
+	})
+
+	return files, err
+}
+
+func matchAnyE5ESourceGlob(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matchE5ESourceGlob(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchE5ESourceGlob matches a slash separated path against a glob pattern. In addition
+// to the syntax supported by path.Match, a "**" segment matches any number of directories.
+func matchE5ESourceGlob(pattern, name string) bool {
+	return matchE5ESourceGlobSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchE5ESourceGlobSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchE5ESourceGlobSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
+
+func expandE5EFunctionSourcePatterns(in []any) []string {
+	patterns := make([]string, 0, len(in))
+	for _, pattern := range in {
+		if pattern, ok := pattern.(string); ok {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
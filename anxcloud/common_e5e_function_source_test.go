@@ -0,0 +1,109 @@
+package anxcloud
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func writeE5ESourceTestFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestBuildE5EFunctionSourceArchive(t *testing.T) {
+	dir := t.TempDir()
+	writeE5ESourceTestFiles(t, dir, map[string]string{
+		"main.py":              "def handler(event, context): pass",
+		"lib/helper.py":        "HELPER = True",
+		"lib/test_helper.py":   "import helper",
+		"node_modules/x/y.js":  "ignored",
+		".git/HEAD":            "ref: refs/heads/main",
+		"README.md":            "# readme",
+		"lib/nested/deeper.py": "DEEPER = True",
+	})
+
+	archive, hash, err := buildE5EFunctionSourceArchive(dir, []string{"**/*.py"}, []string{".git", "node_modules", "**/test_*.py"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatalf("archive is not a valid zip file: %s", err)
+	}
+
+	names := make([]string, 0, len(r.File))
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+
+	if diff := cmp.Diff([]string{"lib/helper.py", "lib/nested/deeper.py", "main.py"}, names); diff != "" {
+		t.Errorf("unexpected archive contents: mismatch (-want +got):\n%s", diff)
+	}
+
+	archiveAgain, hashAgain, err := buildE5EFunctionSourceArchive(dir, []string{"**/*.py"}, []string{".git", "node_modules", "**/test_*.py"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hash != hashAgain || !bytes.Equal(archive, archiveAgain) {
+		t.Errorf("expected archives built from the same files to be identical")
+	}
+
+	writeE5ESourceTestFiles(t, dir, map[string]string{"README.md": "# changed readme"})
+	if _, unchangedHash, _ := buildE5EFunctionSourceArchive(dir, []string{"**/*.py"}, []string{".git", "node_modules", "**/test_*.py"}); unchangedHash != hash {
+		t.Errorf("expected hash to ignore changes of files which aren't packaged")
+	}
+
+	writeE5ESourceTestFiles(t, dir, map[string]string{"main.py": "def handler(event, context): return 1"})
+	if _, changedHash, _ := buildE5EFunctionSourceArchive(dir, []string{"**/*.py"}, []string{".git", "node_modules", "**/test_*.py"}); changedHash == hash {
+		t.Errorf("expected hash to change when a packaged file changes")
+	}
+}
+
+func TestBuildE5EFunctionSourceArchiveEmpty(t *testing.T) {
+	dir := t.TempDir()
+	writeE5ESourceTestFiles(t, dir, map[string]string{"README.md": "# readme"})
+
+	if _, _, err := buildE5EFunctionSourceArchive(dir, []string{"*.py"}, nil); err == nil {
+		t.Errorf("expected an error when no files are packaged")
+	}
+}
+
+func TestMatchE5ESourceGlob(t *testing.T) {
+	cases := []struct {
+		Pattern string
+		Name    string
+		Match   bool
+	}{
+		{"*.py", "main.py", true},
+		{"*.py", "lib/main.py", false},
+		{"**/*.py", "main.py", true},
+		{"**/*.py", "lib/nested/main.py", true},
+		{"lib/**", "lib/nested/main.py", true},
+		{"lib/**", "other/main.py", false},
+		{"lib/**/main.py", "lib/main.py", true},
+		{"node_modules", "node_modules", true},
+		{"node_modules", "lib/node_modules", false},
+	}
+
+	for _, tc := range cases {
+		if match := matchE5ESourceGlob(tc.Pattern, tc.Name); match != tc.Match {
+			t.Errorf("expected pattern %q matching %q to be %t", tc.Pattern, tc.Name, tc.Match)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package anxcloud
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	frontierv1 "go.anx.io/go-anxcloud/pkg/apis/frontier/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// frontierOpenAPIActionExtension is the vendor extension of an OpenAPI operation
+// configuring the Frontier action handling it
+const frontierOpenAPIActionExtension = "x-frontier-action"
+
+var frontierOpenAPIMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+type frontierOpenAPIEndpoint struct {
+	Path    string
+	Name    string
+	Actions []frontierOpenAPIAction
+}
+
+type frontierOpenAPIAction struct {
+	Method   string
+	Type     frontierv1.ActionType
+	URL      string
+	Body     string
+	Language string
+	Function string
+}
+
+// actionKey returns the key of an action in the actions attribute, e.g. "GET /pets/{id}"
+func (e frontierOpenAPIEndpoint) actionKey(action frontierOpenAPIAction) string {
+	return strings.ToUpper(action.Method) + " " + e.Path
+}
+
+// frontierPath returns the endpoint path the way Frontier expects it, without leading slash
+func (e frontierOpenAPIEndpoint) frontierPath() string {
+	return strings.TrimPrefix(e.Path, "/")
+}
+
+func (a frontierOpenAPIAction) frontierAction(identifier, endpointIdentifier string) frontierv1.Action {
+	action := frontierv1.Action{
+		Identifier:         identifier,
+		EndpointIdentifier: endpointIdentifier,
+		HTTPRequestMethod:  a.Method,
+		Type:               a.Type,
+		Meta:               &frontierv1.ActionMeta{},
+	}
+
+	switch a.Type {
+	case frontierv1.ActionTypeURLRewrite:
+		action.Meta.ActionMetaURLRewrite = &frontierv1.ActionMetaURLRewrite{URL: a.URL}
+	case frontierv1.ActionTypeMockResponse:
+		action.Meta.ActionMetaMockResponse = &frontierv1.ActionMetaMockResponse{Body: a.Body, Language: a.Language}
+	case frontierv1.ActionTypeE5EFunction:
+		action.Meta.ActionMetaE5EFunction = &frontierv1.ActionMetaE5EFunction{FunctionIdentifier: a.Function}
+	case frontierv1.ActionTypeE5EAsyncFunction:
+		action.Meta.ActionMetaE5EAsyncFunction = &frontierv1.ActionMetaE5EAsyncFunction{FunctionIdentifier: a.Function}
+	case frontierv1.ActionTypeE5EAsyncResult:
+		action.Meta.ActionMetaE5EAsyncResult = &frontierv1.ActionMetaE5EAsyncResult{FunctionIdentifier: a.Function}
+	}
+
+	return action
+}
+
+func frontierOpenAPIActionFromAction(action frontierv1.Action) frontierOpenAPIAction {
+	out := frontierOpenAPIAction{
+		Method: strings.ToLower(action.HTTPRequestMethod),
+		Type:   action.Type,
+	}
+
+	if action.Meta == nil {
+		return out
+	}
+
+	switch {
+	case action.Type == frontierv1.ActionTypeURLRewrite && action.Meta.ActionMetaURLRewrite != nil:
+		out.URL = action.Meta.ActionMetaURLRewrite.URL
+	case action.Type == frontierv1.ActionTypeMockResponse && action.Meta.ActionMetaMockResponse != nil:
+		out.Body = action.Meta.ActionMetaMockResponse.Body
+		out.Language = action.Meta.ActionMetaMockResponse.Language
+	case action.Type == frontierv1.ActionTypeE5EFunction && action.Meta.ActionMetaE5EFunction != nil:
+		out.Function = action.Meta.ActionMetaE5EFunction.FunctionIdentifier
+	case action.Type == frontierv1.ActionTypeE5EAsyncFunction && action.Meta.ActionMetaE5EAsyncFunction != nil:
+		out.Function = action.Meta.ActionMetaE5EAsyncFunction.FunctionIdentifier
+	case action.Type == frontierv1.ActionTypeE5EAsyncResult && action.Meta.ActionMetaE5EAsyncResult != nil:
+		out.Function = action.Meta.ActionMetaE5EAsyncResult.FunctionIdentifier
+	}
+
+	return out
+}
+
+// parseFrontierOpenAPISpec converts an OpenAPI 3 document, encoded as JSON or YAML, to the
+// endpoints and actions it describes. Every operation must configure its handler with the
+// x-frontier-action extension, containing exactly one of the handler blocks of anxcloud_frontier_action.
+func parseFrontierOpenAPISpec(spec string) ([]frontierOpenAPIEndpoint, error) {
+	document, err := yaml.YAMLToJSON([]byte(spec))
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding specification: %w", err)
+	}
+
+	var parsed struct {
+		OpenAPI string                                `json:"openapi"`
+		Paths   map[string]map[string]json.RawMessage `json:"paths"`
+	}
+
+	if err := json.Unmarshal(document, &parsed); err != nil {
+		return nil, fmt.Errorf("failed decoding specification: %w", err)
+	}
+
+	if !strings.HasPrefix(parsed.OpenAPI, "3.") {
+		return nil, fmt.Errorf("unsupported OpenAPI version %q, expected 3.x", parsed.OpenAPI)
+	}
+
+	endpoints := make([]frontierOpenAPIEndpoint, 0, len(parsed.Paths))
+
+	for path, pathItem := range parsed.Paths {
+		if !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf("path %q must start with a slash", path)
+		}
+
+		if _, ok := pathItem["$ref"]; ok {
+			return nil, fmt.Errorf("path %q: references to path items are not supported", path)
+		}
+
+		endpoint := frontierOpenAPIEndpoint{Path: path, Name: path}
+
+		if summary, ok := pathItem["summary"]; ok {
+			if err := json.Unmarshal(summary, &endpoint.Name); err != nil {
+				return nil, fmt.Errorf("path %q: invalid summary: %w", path, err)
+			}
+		}
+
+		for _, method := range frontierOpenAPIMethods {
+			operation, ok := pathItem[method]
+			if !ok {
+				continue
+			}
+
+			action, err := parseFrontierOpenAPIOperation(operation)
+			if err != nil {
+				return nil, fmt.Errorf("operation %s %s: %w", strings.ToUpper(method), path, err)
+			}
+
+			action.Method = method
+			endpoint.Actions = append(endpoint.Actions, action)
+		}
+
+		if len(endpoint.Actions) == 0 {
+			return nil, fmt.Errorf("path %q has no operations", path)
+		}
+
+		endpoints = append(endpoints, endpoint)
+	}
+
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("specification has no paths")
+	}
+
+	sortFrontierOpenAPIEndpoints(endpoints)
+
+	return endpoints, nil
+}
+
+func parseFrontierOpenAPIOperation(operation json.RawMessage) (frontierOpenAPIAction, error) {
+	var parsed struct {
+		Action map[string]map[string]string `json:"x-frontier-action"`
+	}
+
+	if err := json.Unmarshal(operation, &parsed); err != nil {
+		return frontierOpenAPIAction{}, fmt.Errorf("invalid %s extension: %w", frontierOpenAPIActionExtension, err)
+	}
+
+	if len(parsed.Action) != 1 {
+		return frontierOpenAPIAction{}, fmt.Errorf("%s extension must configure exactly one of: %s",
+			frontierOpenAPIActionExtension, strings.Join(frontierActionTypesExcept(""), ", "))
+	}
+
+	var action frontierOpenAPIAction
+	var fields []string
+
+	for actionType, meta := range parsed.Action {
+		action.Type = frontierv1.ActionType(actionType)
+
+		switch action.Type {
+		case frontierv1.ActionTypeURLRewrite:
+			action.URL = meta["url"]
+			fields = []string{"url"}
+		case frontierv1.ActionTypeMockResponse:
+			action.Body = meta["body"]
+			action.Language = meta["language"]
+			fields = []string{"body", "language"}
+		case frontierv1.ActionTypeE5EFunction, frontierv1.ActionTypeE5EAsyncFunction, frontierv1.ActionTypeE5EAsyncResult:
+			action.Function = meta["function"]
+			fields = []string{"function"}
+		default:
+			return action, fmt.Errorf("unknown action type %q, expected one of: %s", actionType, strings.Join(frontierActionTypesExcept(""), ", "))
+		}
+
+		for key := range meta {
+			if !slices.Contains(fields, key) {
+				return action, fmt.Errorf("%s: unexpected attribute %q", actionType, key)
+			}
+		}
+
+		for _, field := range fields {
+			if meta[field] == "" {
+				return action, fmt.Errorf("%s: %q is required", actionType, field)
+			}
+		}
+	}
+
+	return action, nil
+}
+
+func sortFrontierOpenAPIEndpoints(endpoints []frontierOpenAPIEndpoint) {
+	sort.Slice(endpoints, func(i, j int) bool {
+		return endpoints[i].Path < endpoints[j].Path
+	})
+
+	for _, endpoint := range endpoints {
+		sort.Slice(endpoint.Actions, func(i, j int) bool {
+			return endpoint.Actions[i].Method < endpoint.Actions[j].Method
+		})
+	}
+}
+
+// frontierOpenAPIFingerprint hashes endpoints and actions, which have to be sorted, to detect changes
+func frontierOpenAPIFingerprint(endpoints []frontierOpenAPIEndpoint) string {
+	hash := sha256.New()
+
+	for _, endpoint := range endpoints {
+		fmt.Fprintf(hash, "%q %q\n", endpoint.Path, endpoint.Name)
+
+		for _, action := range endpoint.Actions {
+			fmt.Fprintf(hash, "\t%q %q %q %q %q %q\n", action.Method, action.Type, action.URL, action.Body, action.Language, action.Function)
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
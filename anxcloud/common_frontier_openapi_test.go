@@ -0,0 +1,136 @@
+package anxcloud
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	frontierv1 "go.anx.io/go-anxcloud/pkg/apis/frontier/v1"
+)
+
+const testFrontierOpenAPISpec = `
+openapi: 3.0.3
+info:
+  title: Pets
+  version: 1.0.0
+paths:
+  /pets/{id}:
+    get:
+      x-frontier-action:
+        e5e_function:
+          function: function-identifier
+    delete:
+      x-frontier-action:
+        url_rewrite:
+          url: https://pets.example.com/legacy
+  /pets:
+    summary: Pet collection
+    parameters: []
+    post:
+      x-frontier-action:
+        mock_response:
+          body: '{"created": true}'
+          language: json
+`
+
+func TestParseFrontierOpenAPISpec(t *testing.T) {
+	endpoints, err := parseFrontierOpenAPISpec(testFrontierOpenAPISpec)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []frontierOpenAPIEndpoint{
+		{
+			Path: "/pets",
+			Name: "Pet collection",
+			Actions: []frontierOpenAPIAction{
+				{Method: "post", Type: frontierv1.ActionTypeMockResponse, Body: `{"created": true}`, Language: "json"},
+			},
+		},
+		{
+			Path: "/pets/{id}",
+			Name: "/pets/{id}",
+			Actions: []frontierOpenAPIAction{
+				{Method: "delete", Type: frontierv1.ActionTypeURLRewrite, URL: "https://pets.example.com/legacy"},
+				{Method: "get", Type: frontierv1.ActionTypeE5EFunction, Function: "function-identifier"},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(expected, endpoints); diff != "" {
+		t.Errorf("unexpected endpoints: mismatch (-want +got):\n%s", diff)
+	}
+
+	jsonEndpoints, err := parseFrontierOpenAPISpec(`{
+		"openapi": "3.1.0",
+		"paths": {"/pets": {"summary": "Pet collection", "post": {"x-frontier-action": {"mock_response": {"body": "{\"created\": true}", "language": "json"}}}}}
+	}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(expected[:1], jsonEndpoints); diff != "" {
+		t.Errorf("unexpected endpoints parsed from JSON: mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseFrontierOpenAPISpecErrors(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Spec          string
+		ExpectedError string
+	}{
+		{"swagger 2", `{"swagger": "2.0", "paths": {}}`, "unsupported OpenAPI version"},
+		{"no paths", `{"openapi": "3.0.0", "paths": {}}`, "specification has no paths"},
+		{"no operations", `{"openapi": "3.0.0", "paths": {"/pets": {"summary": "Pets"}}}`, `path "/pets" has no operations`},
+		{"missing extension", `{"openapi": "3.0.0", "paths": {"/pets": {"get": {}}}}`, "operation GET /pets: x-frontier-action extension must configure exactly one of"},
+		{"unknown type", `{"openapi": "3.0.0", "paths": {"/pets": {"get": {"x-frontier-action": {"redirect": {}}}}}}`, `unknown action type "redirect"`},
+		{"missing attribute", `{"openapi": "3.0.0", "paths": {"/pets": {"get": {"x-frontier-action": {"mock_response": {"body": "foo"}}}}}}`, `mock_response: "language" is required`},
+		{"unexpected attribute", `{"openapi": "3.0.0", "paths": {"/pets": {"get": {"x-frontier-action": {"e5e_function": {"function": "foo", "url": "bar"}}}}}}`, `e5e_function: unexpected attribute "url"`},
+		{"path item reference", `{"openapi": "3.0.0", "paths": {"/pets": {"$ref": "#/components/pathItems/pets"}}}`, "references to path items are not supported"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := parseFrontierOpenAPISpec(tc.Spec)
+			if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+				t.Errorf("expected error containing %q, got %v", tc.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func TestFrontierOpenAPIFingerprint(t *testing.T) {
+	desired, err := parseFrontierOpenAPISpec(testFrontierOpenAPISpec)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	remote := []frontierRemoteEndpoint{}
+	for _, endpoint := range desired {
+		r := frontierRemoteEndpoint{
+			endpoint: frontierv1.Endpoint{Identifier: endpoint.Path, Name: endpoint.Name, Path: endpoint.frontierPath()},
+		}
+
+		for _, action := range endpoint.Actions {
+			a := action.frontierAction(endpoint.actionKey(action), endpoint.Path)
+			a.HTTPRequestMethod = strings.ToUpper(a.HTTPRequestMethod)
+			r.actions = append([]frontierv1.Action{a}, r.actions...)
+		}
+
+		remote = append([]frontierRemoteEndpoint{r}, remote...)
+	}
+
+	fingerprint := frontierOpenAPIFingerprint(desired)
+
+	if remoteFingerprint := frontierOpenAPIFingerprint(frontierOpenAPIEndpointsFromRemote(remote)); remoteFingerprint != fingerprint {
+		t.Errorf("expected fingerprint of the deployed tree to match the specification")
+	}
+
+	remote[0].actions[0].Meta.ActionMetaURLRewrite = &frontierv1.ActionMetaURLRewrite{URL: "https://changed.example.com"}
+	remote[0].actions[0].Type = frontierv1.ActionTypeURLRewrite
+
+	if remoteFingerprint := frontierOpenAPIFingerprint(frontierOpenAPIEndpointsFromRemote(remote)); remoteFingerprint == fingerprint {
+		t.Errorf("expected fingerprint to change when an action is changed outside of Terraform")
+	}
+}
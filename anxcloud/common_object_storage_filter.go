@@ -0,0 +1,205 @@
+package anxcloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/api"
+
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+)
+
+// objectStorageFilter selects Object Storage entities in data sources. Keys are "name", which
+// is matched partially and case-insensitive, "customer", which is the customer name returned
+// by the API and matched case-insensitive, and references like "backend", which have to match
+// exactly. Empty values don't filter.
+type objectStorageFilter map[string]string
+
+// schemaObjectStorageFilters returns the filter attributes of a data source listing entities,
+// "name_filter" and a "<reference>_filter" for each of the given references
+func schemaObjectStorageFilters(entities string, references ...string) map[string]*schema.Schema {
+	filters := schemaObjectStorageReferenceFilters(entities, references...)
+	filters["name_filter"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: fmt.Sprintf("Filter %s by name (partial match).", entities),
+	}
+
+	return filters
+}
+
+// schemaObjectStorageReferenceFilters returns a "<reference>_filter" attribute for each of the given references
+func schemaObjectStorageReferenceFilters(entities string, references ...string) map[string]*schema.Schema {
+	filters := map[string]*schema.Schema{}
+
+	for _, reference := range references {
+		description := fmt.Sprintf("Filter %s by %s identifier.", entities, reference)
+		if reference == "customer" {
+			description = objectStorageCustomerFilterDescription(entities)
+		}
+
+		filters[reference+"_filter"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: description,
+		}
+	}
+
+	return filters
+}
+
+// objectStorageCustomerFilterDescription describes the customer filter, which can't match identifiers
+// as the API returns the customer name of Object Storage entities
+func objectStorageCustomerFilterDescription(entities string) string {
+	return fmt.Sprintf("Filter %s by customer name (exact match, ignoring case). "+
+		"The API returns the name of the customer instead of its identifier.", entities)
+}
+
+// expandObjectStorageFilter reads the filter attributes created by schemaObjectStorageFilters
+func expandObjectStorageFilter(d *schema.ResourceData, references ...string) objectStorageFilter {
+	filter := expandObjectStorageReferenceFilter(d, references...)
+	filter["name"] = d.Get("name_filter").(string)
+
+	return filter
+}
+
+// expandObjectStorageReferenceFilter reads the filter attributes created by schemaObjectStorageReferenceFilters
+func expandObjectStorageReferenceFilter(d *schema.ResourceData, references ...string) objectStorageFilter {
+	filter := objectStorageFilter{}
+
+	for _, reference := range references {
+		filter[reference] = d.Get(reference + "_filter").(string)
+	}
+
+	return filter
+}
+
+// objectStorageFilterFields returns name and references of an Object Storage entity, references
+// not returned by the API are empty and don't match any filter
+func objectStorageFilterFields(obj interface{}) map[string]string {
+	switch o := obj.(type) {
+	case *objectstoragev2.Endpoint:
+		return map[string]string{"name": o.URL, "customer": o.Customer}
+	case *objectstoragev2.S3Backend:
+		return map[string]string{"name": o.Name, "customer": o.Customer, "endpoint": o.Endpoint.Identifier}
+	case *objectstoragev2.Region:
+		fields := map[string]string{"name": o.Name, "customer": o.Customer}
+		if o.Backend != nil {
+			fields["backend"] = o.Backend.Identifier
+		}
+		return fields
+	case *objectstoragev2.Tenant:
+		return map[string]string{"name": o.Name, "customer": o.Customer, "backend": o.Backend.Identifier}
+	case *objectstoragev2.User:
+		return map[string]string{
+			"name":     o.UserName,
+			"customer": o.Customer,
+			"backend":  o.Backend.Identifier,
+			"tenant":   o.Tenant.Identifier,
+		}
+	case *objectstoragev2.Bucket:
+		return map[string]string{
+			"name":     o.Name,
+			"customer": o.Customer,
+			"backend":  o.Backend.Identifier,
+			"region":   o.Region.Identifier,
+			"tenant":   o.Tenant.Identifier,
+		}
+	case *objectstoragev2.Key:
+		fields := map[string]string{"name": o.Name, "customer": o.Customer}
+		if o.Backend != nil {
+			fields["backend"] = o.Backend.Identifier
+		}
+		if o.Tenant != nil {
+			fields["tenant"] = o.Tenant.Identifier
+		}
+		if o.User != nil {
+			fields["user"] = o.User.Identifier
+		}
+		return fields
+	}
+
+	return nil
+}
+
+// matches returns true if the fields of an entity, see objectStorageFilterFields, match the filter
+func (f objectStorageFilter) matches(fields map[string]string) bool {
+	for key, value := range f {
+		if value == "" {
+			continue
+		}
+
+		switch key {
+		case "name":
+			if !contains(fields[key], value) {
+				return false
+			}
+		case "customer":
+			if !strings.EqualFold(fields[key], value) {
+				return false
+			}
+		default:
+			if fields[key] != value {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// filterObjectStorage returns the entities matching the filter
+func filterObjectStorage[T any](objects []T, filter objectStorageFilter) []T {
+	filtered := make([]T, 0, len(objects))
+
+	for i := range objects {
+		if filter.matches(objectStorageFilterFields(&objects[i])) {
+			filtered = append(filtered, objects[i])
+		}
+	}
+
+	return filtered
+}
+
+// findObjectStorageByName returns the only entity named exactly like name that matches the filter
+func findObjectStorageByName[T any](objects []T, name string, filter objectStorageFilter) (*T, error) {
+	var found *T
+
+	for i := range objects {
+		fields := objectStorageFilterFields(&objects[i])
+		if fields["name"] != name || !filter.matches(fields) {
+			continue
+		}
+
+		if found != nil {
+			return nil, fmt.Errorf("multiple entities named %q found, use the filters to select one", name)
+		}
+		found = &objects[i]
+	}
+
+	if found == nil {
+		return nil, api.ErrNotFound
+	}
+
+	return found, nil
+}
+
+// setObjectStorageDataSourceFields sets the attributes of a single entity data source from its flattened entity
+func setObjectStorageDataSourceFields(d *schema.ResourceData, fields map[string]interface{}, diags *diag.Diagnostics) {
+	for key, value := range fields {
+		if key == "identifier" {
+			continue
+		}
+
+		if err := d.Set(key, value); err != nil {
+			*diags = append(*diags, diag.FromErr(err)...)
+		}
+	}
+}
+
+// contains checks if s contains substr, ignoring case
+func contains(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
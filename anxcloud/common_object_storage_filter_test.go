@@ -0,0 +1,67 @@
+package anxcloud
+
+import (
+	"errors"
+	"testing"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/apis/common"
+
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+)
+
+func TestObjectStorageFilterMatches(t *testing.T) {
+	fields := map[string]string{"name": "Platform-Tenant", "customer": "Example Customer", "backend": "backend-id"}
+
+	cases := []struct {
+		Name     string
+		Filter   objectStorageFilter
+		Expected bool
+	}{
+		{"empty filter", objectStorageFilter{}, true},
+		{"empty values", objectStorageFilter{"name": "", "backend": ""}, true},
+		{"partial name ignoring case", objectStorageFilter{"name": "platform"}, true},
+		{"other name", objectStorageFilter{"name": "application"}, false},
+		{"matching reference", objectStorageFilter{"name": "tenant", "backend": "backend-id"}, true},
+		{"partial reference", objectStorageFilter{"backend": "backend"}, false},
+		{"reference not returned", objectStorageFilter{"tenant": "tenant-id"}, false},
+		{"customer name ignoring case", objectStorageFilter{"customer": "example customer"}, true},
+		{"partial customer name", objectStorageFilter{"customer": "example"}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if matches := tc.Filter.matches(fields); matches != tc.Expected {
+				t.Errorf("expected %t, got %t", tc.Expected, matches)
+			}
+		})
+	}
+}
+
+func TestFindObjectStorageByName(t *testing.T) {
+	tenants := []objectstoragev2.Tenant{
+		{Identifier: "a", Name: "platform", Backend: common.PartialResource{Identifier: "backend-a"}},
+		{Identifier: "b", Name: "platform", Backend: common.PartialResource{Identifier: "backend-b"}},
+		{Identifier: "c", Name: "platform-staging", Backend: common.PartialResource{Identifier: "backend-a"}},
+	}
+
+	if filtered := filterObjectStorage(tenants, objectStorageFilter{"name": "PLATFORM", "backend": "backend-a"}); len(filtered) != 2 {
+		t.Errorf("expected 2 tenants, got %d", len(filtered))
+	}
+
+	if _, err := findObjectStorageByName(tenants, "platform", objectStorageFilter{}); err == nil {
+		t.Error("expected an error for multiple tenants with the same name")
+	}
+
+	tenant, err := findObjectStorageByName(tenants, "platform", objectStorageFilter{"backend": "backend-b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if tenant.Identifier != "b" {
+		t.Errorf("expected tenant b, got %q", tenant.Identifier)
+	}
+
+	if _, err := findObjectStorageByName(tenants, "platform-prod", objectStorageFilter{}); !errors.Is(err, api.ErrNotFound) {
+		t.Errorf("expected api.ErrNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importStateObjectStorage returns an importer for Object Storage resources referencing parents the
+// API doesn't return. The import ID starts with the customer identifier, as the API only returns the
+// customer name, followed by the parent identifiers in the given order and the identifier of the
+// resource itself, e.g. "<customer>/<backend>/<identifier>". With optionalParents the parents can be
+// omitted, e.g. "<customer>/<identifier>".
+func importStateObjectStorage(optionalParents bool, parents ...string) schema.StateContextFunc {
+	parents = append([]string{"customer"}, parents...)
+
+	return func(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+		parts := strings.Split(d.Id(), "/")
+
+		expectedParents := parents
+		if optionalParents && len(parts) == 2 {
+			expectedParents = parents[:1]
+		}
+
+		if len(parts) != len(expectedParents)+1 || slices.Contains(parts, "") {
+			return nil, fmt.Errorf("unexpected import ID %q, expected %s", d.Id(), objectStorageImportIDFormat(parents))
+		}
+
+		for i, parent := range expectedParents {
+			if err := d.Set(parent, parts[i]); err != nil {
+				return nil, err
+			}
+		}
+
+		d.SetId(parts[len(parts)-1])
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func objectStorageImportIDFormat(parents []string) string {
+	var format strings.Builder
+	for _, parent := range parents {
+		fmt.Fprintf(&format, "<%s>/", parent)
+	}
+	format.WriteString("<identifier>")
+	return format.String()
+}
@@ -0,0 +1,114 @@
+package anxcloud
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestImportStateObjectStorage(t *testing.T) {
+	cases := []struct {
+		Name             string
+		ID               string
+		OptionalParents  bool
+		ExpectedID       string
+		ExpectedCustomer string
+		ExpectedBackend  string
+		ExpectedRegion   string
+		ExpectedError    string
+	}{
+		{"composite", "customer-id/backend-id/region-id/bucket-id", false, "bucket-id", "customer-id", "backend-id", "region-id", ""},
+		{"without parents", "customer-id/bucket-id", true, "bucket-id", "customer-id", "", "", ""},
+		{"without parents not supported", "customer-id/bucket-id", false, "", "", "", "", "expected <customer>/<backend>/<region>/<identifier>"},
+		{"plain identifier", "bucket-id", true, "", "", "", "", "expected <customer>/<backend>/<region>/<identifier>"},
+		{"missing parent", "customer-id/backend-id/bucket-id", true, "", "", "", "", "expected <customer>/<backend>/<region>/<identifier>"},
+		{"empty part", "customer-id/backend-id//bucket-id", false, "", "", "", "", "unexpected import ID"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, schemaObjectStorageBucket(), map[string]any{})
+			d.SetId(tc.ID)
+
+			result, err := importStateObjectStorage(tc.OptionalParents, "backend", "region")(context.TODO(), d, nil)
+			if tc.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+					t.Errorf("expected error containing %q, got %v", tc.ExpectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(result) != 1 {
+				t.Fatalf("expected a single resource, got %d", len(result))
+			}
+
+			if id := result[0].Id(); id != tc.ExpectedID {
+				t.Errorf("expected ID %q, got %q", tc.ExpectedID, id)
+			}
+
+			if customer := result[0].Get("customer").(string); customer != tc.ExpectedCustomer {
+				t.Errorf("expected customer %q, got %q", tc.ExpectedCustomer, customer)
+			}
+
+			if backend := result[0].Get("backend").(string); backend != tc.ExpectedBackend {
+				t.Errorf("expected backend %q, got %q", tc.ExpectedBackend, backend)
+			}
+
+			if region := result[0].Get("region").(string); region != tc.ExpectedRegion {
+				t.Errorf("expected region %q, got %q", tc.ExpectedRegion, region)
+			}
+		})
+	}
+}
+
+func TestImportStateObjectStorageWithoutParents(t *testing.T) {
+	cases := []struct {
+		Name             string
+		ID               string
+		ExpectedID       string
+		ExpectedCustomer string
+		ExpectedError    string
+	}{
+		{"composite", "customer-id/endpoint-id", "endpoint-id", "customer-id", ""},
+		{"plain identifier", "endpoint-id", "", "", "expected <customer>/<identifier>"},
+		{"too many parts", "customer-id/backend-id/endpoint-id", "", "", "expected <customer>/<identifier>"},
+		{"empty customer", "/endpoint-id", "", "", "unexpected import ID"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, schemaObjectStorageEndpoint(), map[string]any{})
+			d.SetId(tc.ID)
+
+			result, err := importStateObjectStorage(false)(context.TODO(), d, nil)
+			if tc.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+					t.Errorf("expected error containing %q, got %v", tc.ExpectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(result) != 1 {
+				t.Fatalf("expected a single resource, got %d", len(result))
+			}
+
+			if id := result[0].Id(); id != tc.ExpectedID {
+				t.Errorf("expected ID %q, got %q", tc.ExpectedID, id)
+			}
+
+			if customer := result[0].Get("customer").(string); customer != tc.ExpectedCustomer {
+				t.Errorf("expected customer %q, got %q", tc.ExpectedCustomer, customer)
+			}
+		})
+	}
+}
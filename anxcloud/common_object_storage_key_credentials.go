@@ -0,0 +1,108 @@
+package anxcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.anx.io/go-anxcloud/pkg/client"
+)
+
+// objectStorageKeySecretMaxSize limits how much of the secret URL's response is read
+const objectStorageKeySecretMaxSize = 1 << 20
+
+// objectStorageKeySecretClient requests secret URLs outside of the Engine API, without credentials
+var objectStorageKeySecretClient = &http.Client{Timeout: 30 * time.Second}
+
+// objectStorageKeySecret is the document behind the secret URL of an Object Storage key
+type objectStorageKeySecret struct {
+	AccessKeyID     string `json:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key"`
+}
+
+// isEngineURL returns true if u has the scheme and host of the Engine API at baseURL
+func isEngineURL(u *url.URL, baseURL string) bool {
+	engine, err := url.Parse(baseURL)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(u.Scheme, engine.Scheme) && strings.EqualFold(u.Host, engine.Host)
+}
+
+// fetchObjectStorageKeySecret retrieves the document behind the secret URL of an Object Storage key.
+// The Engine credentials are only sent if the secret URL points to the Engine API.
+func fetchObjectStorageKeySecret(ctx context.Context, c client.Client, secretURL string) ([]byte, error) {
+	u, err := url.Parse(secretURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	do := objectStorageKeySecretClient.Do
+	if isEngineURL(u, c.BaseURL()) {
+		do = c.Do
+	}
+
+	res, err := do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+
+	return io.ReadAll(io.LimitReader(res.Body, objectStorageKeySecretMaxSize))
+}
+
+// parseObjectStorageKeySecret extracts the S3 credentials from the JSON document behind a secret URL.
+// The returned access key ID is empty if the document doesn't contain it.
+func parseObjectStorageKeySecret(document []byte) (string, string, error) {
+	var secret objectStorageKeySecret
+	if err := json.Unmarshal(document, &secret); err != nil {
+		return "", "", fmt.Errorf("failed decoding secret: %w", err)
+	}
+
+	if secret.SecretAccessKey == "" {
+		return "", "", fmt.Errorf("secret doesn't contain a secret access key")
+	}
+
+	return secret.AccessKeyID, secret.SecretAccessKey, nil
+}
+
+// objectStorageKeyRotationDue returns true if a key expiring at expireDate has to be rotated
+func objectStorageKeyRotationDue(expireDate string, rotateBefore time.Duration, now time.Time) (bool, error) {
+	if expireDate == "" {
+		return false, nil
+	}
+
+	expireTime, err := time.Parse(time.RFC3339, expireDate)
+	if err != nil {
+		return false, err
+	}
+
+	return !now.Before(expireTime.Add(-rotateBefore)), nil
+}
+
+func validateDuration(v any, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q: %w", k, err)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,104 @@
+package anxcloud
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestParseObjectStorageKeySecret(t *testing.T) {
+	cases := []struct {
+		Name                    string
+		Document                string
+		ExpectedAccessKeyID     string
+		ExpectedSecretAccessKey string
+		ExpectError             bool
+	}{
+		{"json", `{"access_key_id": "AKID", "secret_access_key": "s3cr3t"}`, "AKID", "s3cr3t", false},
+		{"json without access key ID", `{"secret_access_key": "s3cr3t"}`, "", "s3cr3t", false},
+		{"json without secret", `{"access_key_id": "AKID"}`, "", "", true},
+		{"other schema", `{"data": {"secret": "s3cr3t"}}`, "", "", true},
+		{"plain text", "s3cr3t", "", "", true},
+		{"html", "<html><body>Login</body></html>", "", "", true},
+		{"invalid json", `{"secret_access_key": `, "", "", true},
+		{"empty", " ", "", "", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			accessKeyID, secretAccessKey, err := parseObjectStorageKeySecret([]byte(tc.Document))
+			if tc.ExpectError {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if accessKeyID != tc.ExpectedAccessKeyID || secretAccessKey != tc.ExpectedSecretAccessKey {
+				t.Errorf("expected credentials %q/%q, got %q/%q", tc.ExpectedAccessKeyID, tc.ExpectedSecretAccessKey, accessKeyID, secretAccessKey)
+			}
+		})
+	}
+}
+
+func TestIsEngineURL(t *testing.T) {
+	cases := []struct {
+		URL      string
+		Expected bool
+	}{
+		{"https://engine.anexia-it.com/api/object_storage/v2/key/secret/abc", true},
+		{"https://ENGINE.anexia-it.com/secret", true},
+		{"http://engine.anexia-it.com/secret", false},
+		{"https://engine.anexia-it.com:8443/secret", false},
+		{"https://engine.anexia-it.com.example.com/secret", false},
+		{"https://example.com/?next=https://engine.anexia-it.com", false},
+	}
+
+	for _, tc := range cases {
+		u, err := url.Parse(tc.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if actual := isEngineURL(u, "https://engine.anexia-it.com"); actual != tc.Expected {
+			t.Errorf("expected %q to be an Engine URL: %t, got %t", tc.URL, tc.Expected, actual)
+		}
+	}
+}
+
+func TestObjectStorageKeyRotationDue(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Name       string
+		ExpireDate string
+		Expected   bool
+	}{
+		{"no expiration date", "", false},
+		{"outside of rotation window", "2024-06-09T12:00:00Z", false},
+		{"start of rotation window", "2024-06-08T12:00:00Z", true},
+		{"within rotation window", "2024-06-02T12:00:00Z", true},
+		{"expired", "2024-05-01T12:00:00Z", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			due, err := objectStorageKeyRotationDue(tc.ExpireDate, 168*time.Hour, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if due != tc.Expected {
+				t.Errorf("expected rotation due to be %t, got %t", tc.Expected, due)
+			}
+		})
+	}
+
+	if _, err := objectStorageKeyRotationDue("tomorrow", time.Hour, now); err == nil {
+		t.Errorf("expected an error for an invalid expiration date")
+	}
+}
@@ -0,0 +1,536 @@
+package anxcloud
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	s3XMLNamespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+	// s3ResponseMaxSize limits how much of a response of the S3 API is read
+	s3ResponseMaxSize = 4 << 20
+
+	// s3MultipartPartSize is the size of the parts of multipart uploads, objects up to this size are uploaded at once
+	s3MultipartPartSize = 16 << 20
+)
+
+// s3Client speaks the S3 API of an Object Storage endpoint directly, for objects and bucket
+// configuration not available via the Object Storage API. Requests use path-style URLs and are
+// signed with AWS Signature Version 4.
+type s3Client struct {
+	endpoint        *url.URL
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	partSize        int64
+	httpClient      *http.Client
+	now             func() time.Time
+}
+
+func newS3Client(endpoint, region, accessKeyID, secretAccessKey string) (*s3Client, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 endpoint: %w", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q, expected an absolute http or https URL", endpoint)
+	}
+
+	return &s3Client{
+		endpoint:        u,
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		partSize:        s3MultipartPartSize,
+		httpClient:      http.DefaultClient,
+		now:             time.Now,
+	}, nil
+}
+
+// s3Error is an error response of the S3 API
+type s3Error struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+func (e *s3Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("S3 request failed with status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("S3 request failed with status %d: %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// isS3ErrorCode returns true if err is an S3 error with one of the given codes
+func isS3ErrorCode(err error, codes ...string) bool {
+	var s3Err *s3Error
+	return errors.As(err, &s3Err) && slices.Contains(codes, s3Err.Code)
+}
+
+// do sends a request for a subresource (e.g. "lifecycle") of a bucket and returns the response body
+func (c *s3Client) do(ctx context.Context, method, bucket, subresource string, body []byte, contentType string) ([]byte, error) {
+	header := http.Header{}
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+
+	_, data, err := c.request(ctx, method, bucket, "", subresource, body, header)
+	return data, err
+}
+
+// request sends a request for a bucket or, if objectKey is set, an object and returns headers and body of the response
+func (c *s3Client) request(ctx context.Context, method, bucket, objectKey, query string, body []byte, header http.Header) (http.Header, []byte, error) {
+	u := *c.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + bucket
+	if objectKey != "" {
+		u.Path += "/" + objectKey
+	}
+	u.RawPath = s3EscapePath(u.Path)
+	u.RawQuery = query
+
+	var bodyReader io.Reader
+	if len(body) > 0 {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for name, values := range header {
+		req.Header[name] = values
+	}
+
+	if len(body) > 0 {
+		checksum := md5.Sum(body)
+		req.Header.Set("Content-Md5", base64.StdEncoding.EncodeToString(checksum[:]))
+	}
+
+	payloadHash := sha256.Sum256(body)
+	c.sign(req, hex.EncodeToString(payloadHash[:]), c.now())
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(res.Body, s3ResponseMaxSize))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if res.StatusCode >= http.StatusMultipleChoices {
+		s3Err := &s3Error{StatusCode: res.StatusCode}
+		// error responses without XML body, e.g. for HEAD requests, only carry the status
+		_ = xml.Unmarshal(data, s3Err)
+		return nil, nil, s3Err
+	}
+
+	return res.Header, data, nil
+}
+
+// s3EscapePath encodes a path the way S3 expects it in canonical requests, every byte
+// except unreserved characters and slashes is percent-encoded
+func s3EscapePath(path string) string {
+	var escaped strings.Builder
+	for _, b := range []byte(path) {
+		if 'A' <= b && b <= 'Z' || 'a' <= b && b <= 'z' || '0' <= b && b <= '9' || strings.IndexByte("-._~/", b) >= 0 {
+			escaped.WriteByte(b)
+		} else {
+			fmt.Fprintf(&escaped, "%%%02X", b)
+		}
+	}
+	return escaped.String()
+}
+
+// sign adds the AWS Signature Version 4 authorization to a request, signing all of its headers
+func (c *s3Client) sign(req *http.Request, payloadHash string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		trimmed := make([]string, 0, len(values))
+		for _, value := range values {
+			trimmed = append(trimmed, strings.Join(strings.Fields(value), " "))
+		}
+		headers[strings.ToLower(name)] = strings.Join(trimmed, ",")
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", name, headers[name])
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		strings.ReplaceAll(req.URL.Query().Encode(), "+", "%20"),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + c.region + "/s3/aws4_request"
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalRequestHash[:])
+
+	key := []byte("AWS4" + c.secretAccessKey)
+	for _, part := range []string{date, c.region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		c.accessKeyID, scope, signedHeaders, hex.EncodeToString(hmacSHA256(key, stringToSign)),
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+type s3LifecycleConfiguration struct {
+	XMLName xml.Name          `xml:"LifecycleConfiguration"`
+	Xmlns   string            `xml:"xmlns,attr,omitempty"`
+	Rules   []s3LifecycleRule `xml:"Rule"`
+}
+
+type s3LifecycleRule struct {
+	ID     string            `xml:"ID,omitempty"`
+	Filter s3LifecycleFilter `xml:"Filter"`
+	// Prefix is the rule filter of the deprecated format, it is only read
+	Prefix                         string                            `xml:"Prefix,omitempty"`
+	Status                         string                            `xml:"Status"`
+	Expiration                     *s3LifecycleExpiration            `xml:"Expiration,omitempty"`
+	NoncurrentVersionExpiration    *s3NoncurrentVersionExpiration    `xml:"NoncurrentVersionExpiration,omitempty"`
+	AbortIncompleteMultipartUpload *s3AbortIncompleteMultipartUpload `xml:"AbortIncompleteMultipartUpload,omitempty"`
+}
+
+type s3LifecycleFilter struct {
+	Prefix string `xml:"Prefix,omitempty"`
+}
+
+type s3LifecycleExpiration struct {
+	Days int `xml:"Days"`
+}
+
+type s3NoncurrentVersionExpiration struct {
+	NoncurrentDays int `xml:"NoncurrentDays"`
+}
+
+type s3AbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation int `xml:"DaysAfterInitiation"`
+}
+
+type s3CORSConfiguration struct {
+	XMLName xml.Name     `xml:"CORSConfiguration"`
+	Xmlns   string       `xml:"xmlns,attr,omitempty"`
+	Rules   []s3CORSRule `xml:"CORSRule"`
+}
+
+type s3CORSRule struct {
+	ID             string   `xml:"ID,omitempty"`
+	AllowedHeaders []string `xml:"AllowedHeader"`
+	AllowedMethods []string `xml:"AllowedMethod"`
+	AllowedOrigins []string `xml:"AllowedOrigin"`
+	ExposeHeaders  []string `xml:"ExposeHeader"`
+	MaxAgeSeconds  int      `xml:"MaxAgeSeconds,omitempty"`
+}
+
+// getBucketLifecycle returns the lifecycle rules of a bucket, which are empty if it has no lifecycle configuration
+func (c *s3Client) getBucketLifecycle(ctx context.Context, bucket string) ([]s3LifecycleRule, error) {
+	data, err := c.do(ctx, http.MethodGet, bucket, "lifecycle", nil, "")
+	if isS3ErrorCode(err, "NoSuchLifecycleConfiguration") {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var configuration s3LifecycleConfiguration
+	if err := xml.Unmarshal(data, &configuration); err != nil {
+		return nil, fmt.Errorf("failed decoding lifecycle configuration: %w", err)
+	}
+
+	return configuration.Rules, nil
+}
+
+// putBucketLifecycle replaces the lifecycle rules of a bucket, the configuration is deleted if there are none
+func (c *s3Client) putBucketLifecycle(ctx context.Context, bucket string, rules []s3LifecycleRule) error {
+	if len(rules) == 0 {
+		_, err := c.do(ctx, http.MethodDelete, bucket, "lifecycle", nil, "")
+		return err
+	}
+
+	body, err := xml.Marshal(s3LifecycleConfiguration{Xmlns: s3XMLNamespace, Rules: rules})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, http.MethodPut, bucket, "lifecycle", body, "application/xml")
+	return err
+}
+
+// getBucketCORS returns the CORS rules of a bucket, which are empty if it has no CORS configuration
+func (c *s3Client) getBucketCORS(ctx context.Context, bucket string) ([]s3CORSRule, error) {
+	data, err := c.do(ctx, http.MethodGet, bucket, "cors", nil, "")
+	if isS3ErrorCode(err, "NoSuchCORSConfiguration") {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var configuration s3CORSConfiguration
+	if err := xml.Unmarshal(data, &configuration); err != nil {
+		return nil, fmt.Errorf("failed decoding CORS configuration: %w", err)
+	}
+
+	return configuration.Rules, nil
+}
+
+// putBucketCORS replaces the CORS rules of a bucket, the configuration is deleted if there are none
+func (c *s3Client) putBucketCORS(ctx context.Context, bucket string, rules []s3CORSRule) error {
+	if len(rules) == 0 {
+		_, err := c.do(ctx, http.MethodDelete, bucket, "cors", nil, "")
+		return err
+	}
+
+	body, err := xml.Marshal(s3CORSConfiguration{Xmlns: s3XMLNamespace, Rules: rules})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, http.MethodPut, bucket, "cors", body, "application/xml")
+	return err
+}
+
+// getBucketPolicy returns the policy document of a bucket, which is empty if it has no policy
+func (c *s3Client) getBucketPolicy(ctx context.Context, bucket string) (string, error) {
+	data, err := c.do(ctx, http.MethodGet, bucket, "policy", nil, "")
+	if isS3ErrorCode(err, "NoSuchBucketPolicy") {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// putBucketPolicy replaces the policy of a bucket, the policy is deleted if the document is empty
+func (c *s3Client) putBucketPolicy(ctx context.Context, bucket, policy string) error {
+	if policy == "" {
+		_, err := c.do(ctx, http.MethodDelete, bucket, "policy", nil, "")
+		return err
+	}
+
+	_, err := c.do(ctx, http.MethodPut, bucket, "policy", []byte(policy), "application/json")
+	return err
+}
+
+// s3ObjectInfo describes an object stored on S3
+type s3ObjectInfo struct {
+	ETag          string
+	VersionID     string
+	ContentType   string
+	ContentLength int64
+	Metadata      map[string]string
+}
+
+func s3ObjectInfoFromHeader(header http.Header) s3ObjectInfo {
+	info := s3ObjectInfo{
+		ETag:        strings.Trim(header.Get("ETag"), `"`),
+		VersionID:   header.Get("X-Amz-Version-Id"),
+		ContentType: header.Get("Content-Type"),
+		Metadata:    map[string]string{},
+	}
+
+	info.ContentLength, _ = strconv.ParseInt(header.Get("Content-Length"), 10, 64)
+
+	for name, values := range header {
+		if key, ok := strings.CutPrefix(strings.ToLower(name), "x-amz-meta-"); ok && len(values) > 0 {
+			info.Metadata[key] = values[0]
+		}
+	}
+
+	return info
+}
+
+// headObject returns the properties of an object, a missing object results in an s3Error with status 404
+func (c *s3Client) headObject(ctx context.Context, bucket, key string) (s3ObjectInfo, error) {
+	header, _, err := c.request(ctx, http.MethodHead, bucket, key, "", nil, nil)
+	if err != nil {
+		return s3ObjectInfo{}, err
+	}
+
+	return s3ObjectInfoFromHeader(header), nil
+}
+
+// deleteObject deletes an object, deleting a missing object is not an error
+func (c *s3Client) deleteObject(ctx context.Context, bucket, key string) error {
+	_, _, err := c.request(ctx, http.MethodDelete, bucket, key, "", nil, nil)
+	return err
+}
+
+type s3InitiateMultipartUploadResult struct {
+	UploadID string `xml:"UploadId"`
+}
+
+type s3CompleteMultipartUpload struct {
+	XMLName xml.Name          `xml:"CompleteMultipartUpload"`
+	Xmlns   string            `xml:"xmlns,attr,omitempty"`
+	Parts   []s3CompletedPart `xml:"Part"`
+}
+
+type s3CompletedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+// putObject uploads size bytes of content as object. Objects larger than the part size are uploaded
+// in parts, only a single part is held in memory. The header may contain content type and metadata.
+func (c *s3Client) putObject(ctx context.Context, bucket, key string, content io.ReaderAt, size int64, header http.Header) (s3ObjectInfo, error) {
+	if size <= c.partSize {
+		body := make([]byte, size)
+		if _, err := content.ReadAt(body, 0); err != nil && err != io.EOF {
+			return s3ObjectInfo{}, err
+		}
+
+		responseHeader, _, err := c.request(ctx, http.MethodPut, bucket, key, "", body, header)
+		if err != nil {
+			return s3ObjectInfo{}, err
+		}
+
+		return s3ObjectInfoFromHeader(responseHeader), nil
+	}
+
+	_, data, err := c.request(ctx, http.MethodPost, bucket, key, "uploads", nil, header)
+	if err != nil {
+		return s3ObjectInfo{}, fmt.Errorf("failed initiating multipart upload: %w", err)
+	}
+
+	var upload s3InitiateMultipartUploadResult
+	if err := xml.Unmarshal(data, &upload); err != nil {
+		return s3ObjectInfo{}, fmt.Errorf("failed decoding multipart upload: %w", err)
+	}
+
+	uploadQuery := "uploadId=" + url.QueryEscape(upload.UploadID)
+
+	info, err := c.uploadParts(ctx, bucket, key, uploadQuery, content, size)
+	if err != nil {
+		// parts of failed uploads are stored until the upload is aborted
+		_, _, _ = c.request(context.WithoutCancel(ctx), http.MethodDelete, bucket, key, uploadQuery, nil, nil)
+		return s3ObjectInfo{}, err
+	}
+
+	return info, nil
+}
+
+func (c *s3Client) uploadParts(ctx context.Context, bucket, key, uploadQuery string, content io.ReaderAt, size int64) (s3ObjectInfo, error) {
+	complete := s3CompleteMultipartUpload{Xmlns: s3XMLNamespace}
+	part := make([]byte, c.partSize)
+
+	for offset, partNumber := int64(0), 1; offset < size; offset, partNumber = offset+c.partSize, partNumber+1 {
+		n, err := content.ReadAt(part[:min(c.partSize, size-offset)], offset)
+		if err != nil && err != io.EOF {
+			return s3ObjectInfo{}, err
+		}
+
+		query := fmt.Sprintf("partNumber=%d&%s", partNumber, uploadQuery)
+		header, _, err := c.request(ctx, http.MethodPut, bucket, key, query, part[:n], nil)
+		if err != nil {
+			return s3ObjectInfo{}, fmt.Errorf("failed uploading part %d: %w", partNumber, err)
+		}
+
+		complete.Parts = append(complete.Parts, s3CompletedPart{PartNumber: partNumber, ETag: header.Get("ETag")})
+	}
+
+	body, err := xml.Marshal(complete)
+	if err != nil {
+		return s3ObjectInfo{}, err
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/xml")
+
+	responseHeader, data, err := c.request(ctx, http.MethodPost, bucket, key, uploadQuery, body, header)
+	if err != nil {
+		return s3ObjectInfo{}, fmt.Errorf("failed completing multipart upload: %w", err)
+	}
+
+	// completing an upload can fail after the response status was sent
+	var result struct {
+		XMLName xml.Name
+		ETag    string `xml:"ETag"`
+		s3Error
+	}
+	if err := xml.Unmarshal(data, &result); err != nil {
+		return s3ObjectInfo{}, fmt.Errorf("failed decoding multipart upload result: %w", err)
+	}
+
+	if result.XMLName.Local == "Error" {
+		result.s3Error.StatusCode = http.StatusOK
+		return s3ObjectInfo{}, fmt.Errorf("failed completing multipart upload: %w", &result.s3Error)
+	}
+
+	info := s3ObjectInfoFromHeader(responseHeader)
+	info.ETag = strings.Trim(result.ETag, `"`)
+
+	return info, nil
+}
+
+// s3ETag calculates the ETag S3 assigns to an object uploaded by putObject with the given part size, which
+// is the MD5 of the content or, for multipart uploads, the MD5 of the MD5s of all parts followed by the part count
+func s3ETag(content io.ReaderAt, size, partSize int64) (string, error) {
+	if size <= partSize {
+		hash := md5.New()
+		if _, err := io.Copy(hash, io.NewSectionReader(content, 0, size)); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}
+
+	partHashes := md5.New()
+	parts := 0
+	for offset := int64(0); offset < size; offset += partSize {
+		hash := md5.New()
+		if _, err := io.Copy(hash, io.NewSectionReader(content, offset, min(partSize, size-offset))); err != nil {
+			return "", err
+		}
+		partHashes.Write(hash.Sum(nil))
+		parts++
+	}
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(partHashes.Sum(nil)), parts), nil
+}
@@ -0,0 +1,89 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+
+	"go.anx.io/go-anxcloud/pkg/api"
+
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+)
+
+const (
+	objectStorageQuotaPolicyWarn = "warn"
+	objectStorageQuotaPolicyDeny = "deny"
+)
+
+// objectStorageBucketUsage is the collective object count and size of buckets
+type objectStorageBucketUsage struct {
+	objectCount float64
+	objectSize  float64
+}
+
+// objectStorageBucketUsageByTenant sums object count and size of the given buckets per tenant identifier,
+// buckets not reporting their usage are skipped
+func objectStorageBucketUsageByTenant(buckets []objectstoragev2.Bucket) map[string]objectStorageBucketUsage {
+	usage := make(map[string]objectStorageBucketUsage)
+
+	for i := range buckets {
+		tenantUsage := usage[buckets[i].Tenant.Identifier]
+
+		if objectCount, err := buckets[i].GetObjectCount(); err == nil {
+			tenantUsage.objectCount += float64(objectCount)
+		}
+		if objectSize, err := buckets[i].GetObjectSize(); err == nil {
+			tenantUsage.objectSize += float64(objectSize)
+		}
+
+		usage[buckets[i].Tenant.Identifier] = tenantUsage
+	}
+
+	return usage
+}
+
+// objectStorageTenantBucketUsage returns the collective size of objects in all buckets of the tenant
+func objectStorageTenantBucketUsage(ctx context.Context, a api.API, tenantID string) (float64, error) {
+	buckets, err := listObjectStorageBuckets(ctx, a)
+	if err != nil {
+		return 0, fmt.Errorf("failed listing buckets: %w", err)
+	}
+
+	return objectStorageBucketUsageByTenant(buckets)[tenantID].objectSize, nil
+}
+
+// objectStorageQuotaUsage returns the bytes counted against the quota, which is the larger one of
+// the usage reported for the tenant and the collective size of objects in its buckets, as both
+// are updated by the backend at different intervals
+func objectStorageQuotaUsage(usage, bucketUsage float64) float64 {
+	if bucketUsage > usage {
+		return bucketUsage
+	}
+
+	return usage
+}
+
+// objectStorageQuotaPercentage returns the percentage of the quota used, 0 if no quota is set
+func objectStorageQuotaPercentage(quota, used float64) float64 {
+	if quota <= 0 {
+		return 0
+	}
+
+	return used / quota * 100
+}
+
+// formatObjectStorageBytes formats a number of bytes with binary prefixes for diagnostics
+func formatObjectStorageBytes(bytes float64) string {
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB"}
+
+	unit := 0
+	for bytes >= 1024 && unit < len(units)-1 {
+		bytes /= 1024
+		unit++
+	}
+
+	if unit == 0 {
+		return fmt.Sprintf("%.0f %s", bytes, units[unit])
+	}
+
+	return fmt.Sprintf("%.2f %s", bytes, units[unit])
+}
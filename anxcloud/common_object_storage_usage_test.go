@@ -0,0 +1,79 @@
+package anxcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFormatObjectStorageBytes(t *testing.T) {
+	cases := map[float64]string{
+		0:                  "0 B",
+		1023:               "1023 B",
+		1536:               "1.50 KiB",
+		5 * 1024 * 1024:    "5.00 MiB",
+		1024 * 1024 * 1024: "1.00 GiB",
+	}
+
+	for bytes, expected := range cases {
+		if formatted := formatObjectStorageBytes(bytes); formatted != expected {
+			t.Errorf("expected %v bytes to be formatted as %q, got %q", bytes, expected, formatted)
+		}
+	}
+}
+
+func TestCheckObjectStorageTenantQuota(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Usage       float64
+		BucketUsage float64
+		Warning     bool
+	}{
+		{"below threshold", 500, 400, false},
+		{"usage at threshold", 900, 0, true},
+		{"bucket usage above threshold", 100, 950, true},
+		{"quota exceeded", 1200, 1100, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, schemaObjectStorageTenant(), map[string]any{
+				"name":         "tenant",
+				"quota":        1000.0,
+				"quota_policy": objectStorageQuotaPolicyWarn,
+			})
+			if err := d.Set("quota_alert_threshold", 0.9); err != nil {
+				t.Fatal(err)
+			}
+			if err := d.Set("usage", tc.Usage); err != nil {
+				t.Fatal(err)
+			}
+			if err := d.Set("bucket_usage", tc.BucketUsage); err != nil {
+				t.Fatal(err)
+			}
+
+			diags := checkObjectStorageTenantQuota(d)
+			if !tc.Warning {
+				if len(diags) != 0 {
+					t.Errorf("expected no diagnostics, got %v", diags)
+				}
+				return
+			}
+
+			if len(diags) != 1 || diags[0].Severity != diag.Warning {
+				t.Fatalf("expected a single warning, got %v", diags)
+			}
+		})
+	}
+}
+
+func TestObjectStorageQuotaPercentage(t *testing.T) {
+	if percentage := objectStorageQuotaPercentage(1000, objectStorageQuotaUsage(250, 500)); percentage != 50 {
+		t.Errorf("expected 50%%, got %v", percentage)
+	}
+
+	if percentage := objectStorageQuotaPercentage(0, 500); percentage != 0 {
+		t.Errorf("expected 0%% without quota, got %v", percentage)
+	}
+}
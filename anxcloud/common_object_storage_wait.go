@@ -0,0 +1,93 @@
+package anxcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+)
+
+// IDs of the final states of Object Storage entities, all other states are transitional
+const (
+	objectStorageStateOK    = "0"
+	objectStorageStateError = "1"
+)
+
+// objectStorageStateErr is returned by waitForObjectStorageState for entities in the Error state
+type objectStorageStateErr struct {
+	kind  string
+	title string
+}
+
+func (e objectStorageStateErr) Error() string {
+	return fmt.Sprintf("%s is in state %q", e.kind, e.title)
+}
+
+// objectStorageState returns the state of an Object Storage entity, nil if the API didn't return one
+func objectStorageState(obj interface{}) *objectstoragev2.GenericAttributeState {
+	switch o := obj.(type) {
+	case *objectstoragev2.Endpoint:
+		return o.State
+	case *objectstoragev2.S3Backend:
+		return o.State
+	case *objectstoragev2.Tenant:
+		return o.State
+	case *objectstoragev2.Bucket:
+		return o.State
+	case *objectstoragev2.User:
+		return o.State
+	case *objectstoragev2.Key:
+		return o.State
+	case *objectstoragev2.Region:
+		return o.State
+	}
+
+	return nil
+}
+
+// waitForObjectStorageState polls the entity until it reached the OK or Error state, as the backend
+// provisions entities asynchronously after they were created or updated. Entities not found yet are
+// polled as well, entities without state are considered ready. kind names the entity in diagnostics.
+func waitForObjectStorageState(ctx context.Context, a api.API, obj types.IdentifiedObject, kind string, timeout time.Duration) diag.Diagnostics {
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		if err := a.Get(ctx, obj); api.IgnoreNotFound(err) != nil {
+			return retry.NonRetryableError(err)
+		} else if err != nil {
+			return retry.RetryableError(fmt.Errorf("waiting for %s to be created: %w", kind, err))
+		}
+
+		state := objectStorageState(obj)
+		if state == nil {
+			return nil
+		}
+
+		switch state.ID {
+		case objectStorageStateOK:
+			return nil
+		case objectStorageStateError:
+			return retry.NonRetryableError(objectStorageStateErr{kind: kind, title: state.Title})
+		}
+
+		return retry.RetryableError(fmt.Errorf("waiting for %s to be ready, current state is %q", kind, state.Title))
+	})
+
+	var stateErr objectStorageStateErr
+	if errors.As(err, &stateErr) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Object Storage %s failed provisioning", kind),
+			Detail:   fmt.Sprintf("The backend reported the %s in state %q.", kind, stateErr.title),
+		}}
+	} else if err != nil {
+		return diag.Errorf("failed waiting for %s: %s", kind, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package anxcloud
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/mockapi"
+	"github.com/golang/mock/gomock"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+)
+
+func expectObjectStorageTenantStates(a *mockapi.MockAPI, states ...*objectstoragev2.GenericAttributeState) {
+	calls := make([]*gomock.Call, 0, len(states))
+
+	for _, state := range states {
+		state := state
+		calls = append(calls, a.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, o types.IdentifiedObject, opts ...types.GetOption) error {
+			if state == nil {
+				return api.ErrNotFound
+			}
+
+			o.(*objectstoragev2.Tenant).State = state
+			return nil
+		}))
+	}
+
+	gomock.InOrder(calls...)
+}
+
+func TestWaitForObjectStorageState(t *testing.T) {
+	pending := &objectstoragev2.GenericAttributeState{ID: "2", Title: "Pending"}
+	ok := &objectstoragev2.GenericAttributeState{ID: objectStorageStateOK, Title: "OK"}
+	failed := &objectstoragev2.GenericAttributeState{ID: objectStorageStateError, Title: "Quota exceeded"}
+
+	cases := []struct {
+		Name          string
+		States        []*objectstoragev2.GenericAttributeState
+		ExpectedError string
+	}{
+		{"ready", []*objectstoragev2.GenericAttributeState{ok}, ""},
+		{"provisioning", []*objectstoragev2.GenericAttributeState{nil, pending, ok}, ""},
+		{"error state", []*objectstoragev2.GenericAttributeState{pending, failed}, `tenant in state "Quota exceeded"`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			a := mockapi.NewMockAPI(ctrl)
+			expectObjectStorageTenantStates(a, tc.States...)
+
+			diags := waitForObjectStorageState(context.TODO(), a, &objectstoragev2.Tenant{Identifier: "tenant-id"}, "tenant", time.Minute)
+			if tc.ExpectedError == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+
+			if len(diags) != 1 || !strings.Contains(diags[0].Detail, tc.ExpectedError) {
+				t.Errorf("expected a diagnostic containing %q, got %v", tc.ExpectedError, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+
+	e5ev1 "go.anx.io/go-anxcloud/pkg/apis/e5e/v1"
+)
+
+func dataSourceE5EApplication() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves an e5e application by identifier or name.",
+		ReadContext: dataSourceE5EApplicationRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Application identifier.",
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Application name.",
+				ExactlyOneOf: []string{"id", "name"},
+			},
+		},
+	}
+}
+
+func dataSourceE5EApplicationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	application := e5ev1.Application{
+		Identifier: d.Get("id").(string),
+		Name:       d.Get("name").(string),
+	}
+
+	if application.Identifier == "" {
+		foundApplication, err := findE5EApplicationByName(ctx, a, application.Name)
+		if err != nil {
+			return diag.Errorf("failed retrieving application by name: %s", err)
+		}
+		application = *foundApplication
+	} else {
+		if err := a.Get(ctx, &application); err != nil {
+			return diag.Errorf("failed retrieving application by id: %s", err)
+		}
+	}
+
+	d.SetId(application.Identifier)
+
+	if err := d.Set("name", application.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func listE5EApplications(ctx context.Context, a api.API) ([]e5ev1.Application, error) {
+	var channel types.ObjectChannel
+	if err := a.List(ctx, &e5ev1.Application{}, api.ObjectChannel(&channel)); err != nil {
+		return nil, fmt.Errorf("failed listing applications: %s", err)
+	}
+
+	var applications []e5ev1.Application
+	for retriever := range channel {
+		var application e5ev1.Application
+		if err := retriever(&application); err != nil {
+			return nil, fmt.Errorf("failed retrieving application: %s", err)
+		}
+
+		applications = append(applications, application)
+	}
+
+	return applications, nil
+}
+
+func findE5EApplicationByName(ctx context.Context, a api.API, name string) (*e5ev1.Application, error) {
+	applications, err := listE5EApplications(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *e5ev1.Application
+	for i := range applications {
+		if applications[i].Name != name {
+			continue
+		}
+
+		if found != nil {
+			return nil, fmt.Errorf("multiple applications named %q found", name)
+		}
+		found = &applications[i]
+	}
+
+	if found == nil {
+		return nil, api.ErrNotFound
+	}
+
+	return found, nil
+}
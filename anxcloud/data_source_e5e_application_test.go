@@ -0,0 +1,48 @@
+package anxcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/testutils/environment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAnxCloudE5EApplicationDataSource(t *testing.T) {
+	environment.SkipIfNoEnvironment(t)
+
+	runName := environment.GetEnvInfo(t).TestRunName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "anxcloud_e5e_application" "foo" {
+					name = "terraform-test-application-ds-%[1]s"
+				}
+
+				data "anxcloud_e5e_application" "by_id" {
+					id = anxcloud_e5e_application.foo.id
+				}
+
+				data "anxcloud_e5e_application" "by_name" {
+					name = anxcloud_e5e_application.foo.name
+				}
+
+				data "anxcloud_e5e_applications" "foo" {
+					name_filter = "terraform-test-application-ds-%[1]s"
+					depends_on  = [anxcloud_e5e_application.foo]
+				}
+				`, runName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.anxcloud_e5e_application.by_id", "name", "anxcloud_e5e_application.foo", "name"),
+					resource.TestCheckResourceAttrPair("data.anxcloud_e5e_application.by_name", "id", "anxcloud_e5e_application.foo", "id"),
+					resource.TestCheckResourceAttr("data.anxcloud_e5e_applications.foo", "applications.#", "1"),
+					resource.TestCheckResourceAttrPair("data.anxcloud_e5e_applications.foo", "applications.0.id", "anxcloud_e5e_application.foo", "id"),
+				),
+			},
+		},
+	})
+}
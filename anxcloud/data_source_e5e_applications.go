@@ -0,0 +1,71 @@
+package anxcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceE5EApplications() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of e5e applications.",
+		ReadContext: dataSourceE5EApplicationsRead,
+		Schema: map[string]*schema.Schema{
+			"name_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter applications by name (partial match).",
+			},
+			"applications": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of applications.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Application identifier.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Application name.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceE5EApplicationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+	nameFilter := d.Get("name_filter").(string)
+
+	applications, err := listE5EApplications(ctx, a)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	applicationList := make([]interface{}, 0, len(applications))
+	for _, application := range applications {
+		if nameFilter != "" && !contains(application.Name, nameFilter) {
+			continue
+		}
+
+		applicationList = append(applicationList, map[string]interface{}{
+			"id":   application.Identifier,
+			"name": application.Name,
+		})
+	}
+
+	if err := d.Set("applications", applicationList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(generateDataSourceID())
+
+	return nil
+}
@@ -0,0 +1,242 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+
+	e5ev1 "go.anx.io/go-anxcloud/pkg/apis/e5e/v1"
+)
+
+func dataSourceE5EFunction() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves an e5e function by identifier or by name and optionally application." +
+			" The values of secret environment variables and the storage backend configuration are not exposed.",
+		ReadContext: dataSourceE5EFunctionRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Function identifier.",
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Function name.",
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"application": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Application identifier of the function. Restricts the lookup by name to this application.",
+			},
+			"runtime": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Function runtime.",
+			},
+			"entrypoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Function entrypoint.",
+			},
+			"worker_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Worker type.",
+			},
+			"deployment_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the latest deployment.",
+			},
+			"keep_alive": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Keep-alive time.",
+			},
+			"quota_storage": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Space in MiB e5e will grant your function to write any sort of files.",
+			},
+			"quota_memory": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Memory in MiB e5e will grant your function.",
+			},
+			"quota_cpu": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "CPU time in percent the e5e platform will grant your function on execution.",
+			},
+			"quota_timeout": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Time in seconds your function can take to execute before it is killed by the e5e platform.",
+			},
+			"quota_concurrency": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of parallel executions of the function there can be.",
+			},
+			"env": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Environment variables available to the function. Values of secret environment variables are empty.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":   {Type: schema.TypeString, Computed: true},
+						"value":  {Type: schema.TypeString, Computed: true},
+						"secret": {Type: schema.TypeBool, Computed: true},
+					},
+				},
+			},
+			"hostname": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Custom host entries that are available when running your function.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {Type: schema.TypeString, Computed: true},
+						"ip":       {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceE5EFunctionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	function := e5ev1.Function{Identifier: d.Get("id").(string)}
+
+	if function.Identifier == "" {
+		foundFunction, err := findE5EFunctionByName(ctx, a, d.Get("name").(string), d.Get("application").(string))
+		if err != nil {
+			return diag.Errorf("failed retrieving function by name: %s", err)
+		}
+		function.Identifier = foundFunction.Identifier
+	}
+
+	if err := a.Get(ctx, &function); err != nil {
+		return diag.Errorf("failed retrieving function by id: %s", err)
+	}
+
+	d.SetId(function.Identifier)
+
+	var diags diag.Diagnostics
+	for key, val := range flattenE5EFunction(function) {
+		if key == "id" {
+			continue
+		}
+
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+func listE5EFunctions(ctx context.Context, a api.API) ([]e5ev1.Function, error) {
+	var channel types.ObjectChannel
+	if err := a.List(ctx, &e5ev1.Function{}, api.ObjectChannel(&channel)); err != nil {
+		return nil, fmt.Errorf("failed listing functions: %s", err)
+	}
+
+	var functions []e5ev1.Function
+	for retriever := range channel {
+		var function e5ev1.Function
+		if err := retriever(&function); err != nil {
+			return nil, fmt.Errorf("failed retrieving function: %s", err)
+		}
+
+		functions = append(functions, function)
+	}
+
+	return functions, nil
+}
+
+// findE5EFunctionByName looks up a function by name. If application is not empty, only
+// functions of this application are considered.
+func findE5EFunctionByName(ctx context.Context, a api.API, name, application string) (*e5ev1.Function, error) {
+	functions, err := listE5EFunctions(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *e5ev1.Function
+	for i := range functions {
+		if functions[i].Name != name || (application != "" && functions[i].ApplicationIdentifier != application) {
+			continue
+		}
+
+		if found != nil {
+			return nil, fmt.Errorf("multiple functions named %q found, set `application` to select one", name)
+		}
+		found = &functions[i]
+	}
+
+	if found == nil {
+		return nil, api.ErrNotFound
+	}
+
+	return found, nil
+}
+
+// flattenE5EFunction flattens a function without the values of secret environment variables
+func flattenE5EFunction(function e5ev1.Function) map[string]interface{} {
+	envVars := []interface{}{}
+	if function.EnvironmentVariables != nil {
+		for _, envVar := range *function.EnvironmentVariables {
+			value := envVar.Value
+			if envVar.Secret {
+				value = ""
+			}
+
+			envVars = append(envVars, map[string]interface{}{
+				"name":   envVar.Name,
+				"value":  value,
+				"secret": envVar.Secret,
+			})
+		}
+	}
+
+	hostnames := []interface{}{}
+	if function.Hostnames != nil {
+		for _, hostname := range *function.Hostnames {
+			hostnames = append(hostnames, map[string]interface{}{
+				"hostname": hostname.Hostname,
+				"ip":       hostname.IP,
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"id":                function.Identifier,
+		"name":              function.Name,
+		"application":       function.ApplicationIdentifier,
+		"runtime":           function.Runtime,
+		"entrypoint":        function.Entrypoint,
+		"worker_type":       function.WorkerType,
+		"deployment_state":  function.DeploymentState,
+		"keep_alive":        function.KeepAlive,
+		"quota_storage":     function.QuotaStorage,
+		"quota_memory":      function.QuotaMemory,
+		"quota_cpu":         function.QuotaCPU,
+		"quota_timeout":     function.QuotaTimeout,
+		"quota_concurrency": function.QuotaConcurrency,
+		"env":               envVars,
+		"hostname":          hostnames,
+	}
+}
@@ -0,0 +1,139 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	e5ev1internal "github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/apis/e5e/v1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+func dataSourceE5EFunctionDeployments() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides the deployments of an e5e function, newest first.",
+		ReadContext: dataSourceE5EFunctionDeploymentsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"function_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Function identifier.",
+			},
+			"deployments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of deployments.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Deployment identifier.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Deployment state.",
+						},
+						"error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Error reported by the deployment, if it failed.",
+						},
+						"log": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Deployment log.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time the deployment was started.",
+						},
+						"finished_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time the deployment finished.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceE5EFunctionDeploymentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+	functionID := d.Get("function_id").(string)
+
+	deployments, err := listE5EFunctionDeployments(ctx, a, functionID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentList := make([]interface{}, 0, len(deployments))
+	for _, deployment := range deployments {
+		deploymentList = append(deploymentList, map[string]interface{}{
+			"id":          deployment.Identifier,
+			"state":       deployment.State,
+			"error":       deployment.Error,
+			"log":         deployment.Log,
+			"created_at":  deployment.CreatedAt,
+			"finished_at": deployment.FinishedAt,
+		})
+	}
+
+	if err := d.Set("deployments", deploymentList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(functionID)
+
+	return nil
+}
+
+// listE5EFunctionDeployments returns all deployments of a function, newest first
+func listE5EFunctionDeployments(ctx context.Context, a api.API, functionID string) ([]e5ev1internal.E5EFunctionDeploymentInfo, error) {
+	var pageIter types.PageInfo
+	if err := a.List(ctx, &e5ev1internal.E5EFunctionDeploymentInfo{FunctionIdentifier: functionID}, api.Paged(1, 100, &pageIter)); err != nil {
+		return nil, fmt.Errorf("failed listing deployments: %w", err)
+	}
+
+	deployments := make([]e5ev1internal.E5EFunctionDeploymentInfo, 0, pageIter.TotalItems())
+	var pagedDeployments []e5ev1internal.E5EFunctionDeploymentInfo
+	for pageIter.Next(&pagedDeployments) {
+		deployments = append(deployments, pagedDeployments...)
+	}
+
+	if err := pageIter.Error(); err != nil {
+		return nil, fmt.Errorf("failed listing deployments: %w", err)
+	}
+
+	// timestamps are RFC 3339 formatted and therefore sort lexically
+	sort.SliceStable(deployments, func(i, j int) bool {
+		return deployments[i].CreatedAt > deployments[j].CreatedAt
+	})
+
+	return deployments, nil
+}
+
+// latestE5EFunctionDeployment returns the newest deployment of a function or nil if it was never deployed
+func latestE5EFunctionDeployment(ctx context.Context, a api.API, functionID string) (*e5ev1internal.E5EFunctionDeploymentInfo, error) {
+	deployments, err := listE5EFunctionDeployments(ctx, a, functionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(deployments) == 0 {
+		return nil, nil
+	}
+
+	return &deployments[0], nil
+}
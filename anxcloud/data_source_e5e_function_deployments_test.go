@@ -0,0 +1,48 @@
+package anxcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/testutils/environment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAnxCloudE5EFunctionDeploymentsDataSource(t *testing.T) {
+	environment.SkipIfNoEnvironment(t)
+
+	runName := environment.GetEnvInfo(t).TestRunName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "anxcloud_e5e_application" "foo" {
+					name = "terraform-test-deployments-%[1]s"
+				}
+
+				resource "anxcloud_e5e_function" "foo" {
+					name        = "terraform-test-deployments-%[1]s"
+					application = anxcloud_e5e_application.foo.id
+					runtime     = "python_310"
+					entrypoint  = "foo::Bar"
+
+					storage_backend_git {
+						url = "https://foo.bar/foo.git"
+					}
+				}
+
+				data "anxcloud_e5e_function_deployments" "foo" {
+					function_id = anxcloud_e5e_function.foo.id
+				}
+				`, runName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.anxcloud_e5e_function_deployments.foo", "id", "anxcloud_e5e_function.foo", "id"),
+					resource.TestCheckResourceAttr("data.anxcloud_e5e_function_deployments.foo", "deployments.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,114 @@
+package anxcloud
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	e5ev1internal "github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/apis/e5e/v1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	e5ev1 "go.anx.io/go-anxcloud/pkg/apis/e5e/v1"
+)
+
+// e5eInvocationBodyExcerptLength is the maximum number of bytes of the response body
+// included in the error when the status doesn't match expect_status
+const e5eInvocationBodyExcerptLength = 1024
+
+func dataSourceE5EFunctionInvocation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Synchronously invokes a deployed e5e function with a JSON payload and provides its response." +
+			" The function is invoked every time the data source is read, which includes plan and refresh," +
+			" so only idempotent functions should be invoked this way.",
+		ReadContext: dataSourceE5EFunctionInvocationRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"function_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Identifier of the function to invoke. The function has to be deployed.",
+			},
+			"payload": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "{}",
+				ValidateFunc: validation.StringIsJSON,
+				Description:  "JSON encoded payload passed to the function.",
+			},
+			"expect_status": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(100, 599),
+				Description:  "Expected status code of the response. Reading the data source fails if the function responds with a different status.",
+			},
+			"status": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Status code of the response.",
+			},
+			"response_body": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Body of the response.",
+			},
+			"response_headers": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Headers of the response.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceE5EFunctionInvocationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+	functionID := d.Get("function_id").(string)
+
+	function := e5ev1.Function{Identifier: functionID}
+	if err := a.Get(ctx, &function); err != nil {
+		return diag.Errorf("failed retrieving function: %s", err)
+	}
+
+	if function.DeploymentState != "deployed" {
+		return diag.Errorf("function %q can't be invoked, its deployment state is %q", functionID, function.DeploymentState)
+	}
+
+	invocation := e5ev1internal.E5EFunctionInvocation{
+		FunctionIdentifier: functionID,
+		Payload:            json.RawMessage(d.Get("payload").(string)),
+	}
+
+	if err := a.Create(ctx, &invocation); err != nil {
+		return diag.Errorf("failed invoking function: %s", err)
+	}
+
+	if expected, ok := d.GetOk("expect_status"); ok && expected.(int) != invocation.Status {
+		body := invocation.ResponseBody
+		if len(body) > e5eInvocationBodyExcerptLength {
+			body = body[:e5eInvocationBodyExcerptLength] + "..."
+		}
+
+		return diag.Errorf("function responded with status %d instead of %d: %s", invocation.Status, expected.(int), body)
+	}
+
+	var diags diag.Diagnostics
+
+	if err := d.Set("status", invocation.Status); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("response_body", invocation.ResponseBody); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("response_headers", invocation.ResponseHeaders); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	d.SetId(generateDataSourceID())
+
+	return diags
+}
@@ -0,0 +1,96 @@
+package anxcloud
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	e5ev1internal "github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/apis/e5e/v1"
+	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/mockapi"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+
+	e5ev1 "go.anx.io/go-anxcloud/pkg/apis/e5e/v1"
+)
+
+func expectE5EFunctionInvocation(a *mockapi.MockAPI, deploymentState string, status int) {
+	a.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, o types.IdentifiedObject, opts ...types.GetOption) error {
+		o.(*e5ev1.Function).DeploymentState = deploymentState
+		return nil
+	})
+
+	if deploymentState != "deployed" {
+		return
+	}
+
+	a.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, o types.Object, opts ...types.CreateOption) error {
+		invocation := o.(*e5ev1internal.E5EFunctionInvocation)
+		if string(invocation.Payload) != `{"foo":"bar"}` {
+			return errors.New("unexpected payload")
+		}
+
+		invocation.Status = status
+		invocation.ResponseBody = `{"result":"ok"}`
+		invocation.ResponseHeaders = map[string]string{"Content-Type": "application/json"}
+		return nil
+	})
+}
+
+func TestE5EFunctionInvocationDataSource(t *testing.T) {
+	cases := []struct {
+		Name            string
+		DeploymentState string
+		Status          int
+		ExpectStatus    int
+		ExpectedError   string
+	}{
+		{"success", "deployed", 200, 200, ""},
+		{"no expectation", "deployed", 500, 0, ""},
+		{"unexpected status", "deployed", 500, 200, "function responded with status 500 instead of 200"},
+		{"not deployed", "pending", 0, 0, `its deployment state is "pending"`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			a := mockapi.NewMockAPI(ctrl)
+			expectE5EFunctionInvocation(a, tc.DeploymentState, tc.Status)
+
+			raw := map[string]interface{}{
+				"function_id": "foo",
+				"payload":     `{"foo":"bar"}`,
+			}
+			if tc.ExpectStatus != 0 {
+				raw["expect_status"] = tc.ExpectStatus
+			}
+
+			d := schema.TestResourceDataRaw(t, dataSourceE5EFunctionInvocation().Schema, raw)
+			diags := dataSourceE5EFunctionInvocationRead(context.Background(), d, providerContext{api: a})
+
+			if tc.ExpectedError != "" {
+				if !diags.HasError() || !strings.Contains(diags[0].Summary, tc.ExpectedError) {
+					t.Fatalf("expected error containing %q, got %v", tc.ExpectedError, diags)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if status := d.Get("status").(int); status != tc.Status {
+				t.Errorf("expected status %d, got %d", tc.Status, status)
+			}
+
+			if body := d.Get("response_body").(string); body != `{"result":"ok"}` {
+				t.Errorf("unexpected response body %q", body)
+			}
+
+			if contentType := d.Get("response_headers.Content-Type"); contentType != "application/json" {
+				t.Errorf("unexpected content type header %q", contentType)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package anxcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/testutils/environment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAnxCloudE5EFunctionDataSource(t *testing.T) {
+	environment.SkipIfNoEnvironment(t)
+
+	runName := environment.GetEnvInfo(t).TestRunName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "anxcloud_e5e_application" "foo" {
+					name = "terraform-test-function-ds-%[1]s"
+				}
+
+				resource "anxcloud_e5e_function" "foo" {
+					name        = "terraform-test-function-ds-%[1]s"
+					application = anxcloud_e5e_application.foo.id
+					runtime     = "python_310"
+					entrypoint  = "foo::Bar"
+
+					storage_backend_git {
+						url = "https://foo.bar/foo.git"
+					}
+
+					env {
+						name  = "foo"
+						value = "bar"
+					}
+
+					env {
+						name   = "secret"
+						value  = "secret"
+						secret = true
+					}
+				}
+
+				data "anxcloud_e5e_function" "by_name" {
+					name        = anxcloud_e5e_function.foo.name
+					application = anxcloud_e5e_application.foo.id
+				}
+
+				data "anxcloud_e5e_functions" "foo" {
+					application = anxcloud_e5e_application.foo.id
+					depends_on  = [anxcloud_e5e_function.foo]
+				}
+				`, runName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.anxcloud_e5e_function.by_name", "id", "anxcloud_e5e_function.foo", "id"),
+					resource.TestCheckResourceAttr("data.anxcloud_e5e_function.by_name", "runtime", "python_310"),
+					resource.TestCheckResourceAttr("data.anxcloud_e5e_function.by_name", "env.0.value", "bar"),
+					resource.TestCheckResourceAttr("data.anxcloud_e5e_function.by_name", "env.1.value", ""),
+					resource.TestCheckResourceAttr("data.anxcloud_e5e_functions.foo", "functions.#", "1"),
+					resource.TestCheckResourceAttrPair("data.anxcloud_e5e_functions.foo", "functions.0.id", "anxcloud_e5e_function.foo", "id"),
+				),
+			},
+		},
+	})
+}
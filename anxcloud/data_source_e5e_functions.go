@@ -0,0 +1,112 @@
+package anxcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceE5EFunctions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of e5e functions.",
+		ReadContext: dataSourceE5EFunctionsRead,
+		Schema: map[string]*schema.Schema{
+			"application": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list functions of the application with this identifier.",
+			},
+			"name_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter functions by name (partial match).",
+			},
+			"functions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of functions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Function identifier.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Function name.",
+						},
+						"application": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Application identifier of the function.",
+						},
+						"runtime": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Function runtime.",
+						},
+						"entrypoint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Function entrypoint.",
+						},
+						"worker_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Worker type.",
+						},
+						"deployment_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the latest deployment.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceE5EFunctionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+	application := d.Get("application").(string)
+	nameFilter := d.Get("name_filter").(string)
+
+	functions, err := listE5EFunctions(ctx, a)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	functionList := make([]interface{}, 0, len(functions))
+	for _, function := range functions {
+		if application != "" && function.ApplicationIdentifier != application {
+			continue
+		}
+
+		if nameFilter != "" && !contains(function.Name, nameFilter) {
+			continue
+		}
+
+		flattened := flattenE5EFunction(function)
+		functionList = append(functionList, map[string]interface{}{
+			"id":               flattened["id"],
+			"name":             flattened["name"],
+			"application":      flattened["application"],
+			"runtime":          flattened["runtime"],
+			"entrypoint":       flattened["entrypoint"],
+			"worker_type":      flattened["worker_type"],
+			"deployment_state": flattened["deployment_state"],
+		})
+	}
+
+	if err := d.Set("functions", functionList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(generateDataSourceID())
+
+	return nil
+}
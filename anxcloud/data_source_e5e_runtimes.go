@@ -0,0 +1,61 @@
+package anxcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceE5ERuntimes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides the runtimes available for e5e functions.",
+		ReadContext: dataSourceE5ERuntimesRead,
+		Schema: map[string]*schema.Schema{
+			"runtimes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of runtimes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Runtime identifier, used as `runtime` of `anxcloud_e5e_function`.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Runtime name.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceE5ERuntimesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	pc := m.(providerContext)
+
+	runtimes, err := pc.e5eCatalog.Runtimes(ctx, pc.api)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	runtimeList := make([]interface{}, 0, len(runtimes))
+	for _, runtime := range runtimes {
+		runtimeList = append(runtimeList, map[string]interface{}{
+			"id":   runtime.Identifier,
+			"name": runtime.Name,
+		})
+	}
+
+	if err := d.Set("runtimes", runtimeList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(generateDataSourceID())
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package anxcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceE5EWorkerTypes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides the worker types available for e5e functions and their quota limits.",
+		ReadContext: dataSourceE5EWorkerTypesRead,
+		Schema: map[string]*schema.Schema{
+			"worker_types": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of worker types.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Worker type identifier, used as `worker_type` of `anxcloud_e5e_function`.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Worker type name.",
+						},
+						"max_quota_memory": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum `quota_memory` in MiB, 0 means unlimited.",
+						},
+						"max_quota_cpu": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum `quota_cpu` in percent, 0 means unlimited.",
+						},
+						"max_quota_timeout": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum `quota_timeout` in seconds, 0 means unlimited.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceE5EWorkerTypesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	pc := m.(providerContext)
+
+	workerTypes, err := pc.e5eCatalog.WorkerTypes(ctx, pc.api)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	workerTypeList := make([]interface{}, 0, len(workerTypes))
+	for _, workerType := range workerTypes {
+		workerTypeList = append(workerTypeList, map[string]interface{}{
+			"id":                workerType.Identifier,
+			"name":              workerType.Name,
+			"max_quota_memory":  workerType.MaxQuotaMemory,
+			"max_quota_cpu":     workerType.MaxQuotaCPU,
+			"max_quota_timeout": workerType.MaxQuotaTimeout,
+		})
+	}
+
+	if err := d.Set("worker_types", workerTypeList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(generateDataSourceID())
+
+	return nil
+}
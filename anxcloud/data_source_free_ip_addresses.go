@@ -0,0 +1,124 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/ipam/address"
+	"go.anx.io/go-anxcloud/pkg/ipam/prefix"
+)
+
+func dataSourceFreeIPAddresses() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Provides addresses of a network prefix which are not in use, without reserving them.
+
+### Known limitations
+
+- Every address known to the IPAM is considered to be in use, regardless of its status.
+- The returned addresses are not reserved and might be taken by someone else before they are created.
+`,
+		ReadContext: dataSourceFreeIPAddressesRead,
+		Schema:      schemaFreeIPAddresses(),
+	}
+}
+
+func dataSourceFreeIPAddressesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(providerContext).legacyClient
+	p := prefix.NewAPI(c)
+	a := address.NewAPI(c)
+
+	prefixID := d.Get("network_prefix_id").(string)
+	count := d.Get("count").(int)
+
+	info, err := p.Get(ctx, prefixID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get network prefix with id '%s': %w", prefixID, err))
+	}
+
+	// CIDR value is set in the 'name' field
+	_, network, err := net.ParseCIDR(info.Name)
+	if err != nil {
+		return diag.Errorf("failed to parse CIDR %q of network prefix: %s", info.Name, err)
+	}
+
+	addresses, err := listAllPages(func(page int) ([]address.Summary, error) {
+		return a.GetFiltered(ctx, page, 100, address.PrefixFilter(prefixID))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	used := make(map[string]struct{}, len(addresses))
+	for _, addr := range addresses {
+		// normalize address (engine uses shortened, lowercase IPv6 address names)
+		if ip := net.ParseIP(addr.Name); ip != nil {
+			used[ip.String()] = struct{}{}
+		}
+	}
+
+	free := findFreeAddresses(network, used, count)
+	if len(free) < count {
+		return diag.Errorf("network prefix %q has only %d free addresses, but %d were requested", info.Name, len(free), count)
+	}
+
+	if err := d.Set("addresses", free); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s-%d", prefixID, count))
+
+	return nil
+}
+
+// findFreeAddresses returns up to count addresses of network which are not in used.
+// The network address and, for IPv4, the broadcast address are never returned.
+func findFreeAddresses(network *net.IPNet, used map[string]struct{}, count int) []string {
+	free := make([]string, 0, count)
+
+	ip := nextIP(network.IP.Mask(network.Mask))
+	for ; network.Contains(ip) && len(free) < count; ip = nextIP(ip) {
+		if ip.To4() != nil && isBroadcastAddress(network, ip) {
+			break
+		}
+
+		if _, ok := used[ip.String()]; !ok {
+			free = append(free, ip.String())
+		}
+	}
+
+	return free
+}
+
+func nextIP(ip net.IP) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+
+	return next
+}
+
+func isBroadcastAddress(network *net.IPNet, ip net.IP) bool {
+	ip = ip.To4()
+	mask := network.Mask
+	if len(mask) == net.IPv6len {
+		mask = mask[12:]
+	}
+
+	for i := range ip {
+		if ip[i]|mask[i] != 0xff {
+			return false
+		}
+	}
+
+	return true
+}
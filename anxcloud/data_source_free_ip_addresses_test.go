@@ -0,0 +1,68 @@
+package anxcloud
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/testutils/environment"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAnxCloudFreeIPAddressesDataSource(t *testing.T) {
+	environment.SkipIfNoEnvironment(t)
+
+	envInfo := environment.GetEnvInfo(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "anxcloud_free_ip_addresses" "foo" {
+					network_prefix_id = %q
+					count             = 3
+				}
+				`, envInfo.Prefix.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.anxcloud_free_ip_addresses.foo", "addresses.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindFreeAddresses(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		CIDR     string
+		Used     []string
+		Count    int
+		Expected []string
+	}{
+		{"empty ipv4 prefix", "10.0.0.0/29", nil, 2, []string{"10.0.0.1", "10.0.0.2"}},
+		{"used ipv4 addresses are skipped", "10.0.0.0/29", []string{"10.0.0.1", "10.0.0.3"}, 2, []string{"10.0.0.2", "10.0.0.4"}},
+		{"ipv4 broadcast is skipped", "10.0.0.0/30", nil, 5, []string{"10.0.0.1", "10.0.0.2"}},
+		{"ipv6 prefix", "2001:db8::/126", []string{"2001:db8::1"}, 5, []string{"2001:db8::2", "2001:db8::3"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, network, err := net.ParseCIDR(testCase.CIDR)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			used := make(map[string]struct{}, len(testCase.Used))
+			for _, u := range testCase.Used {
+				used[u] = struct{}{}
+			}
+
+			if diff := cmp.Diff(testCase.Expected, findFreeAddresses(network, used, testCase.Count)); diff != "" {
+				t.Errorf("(-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
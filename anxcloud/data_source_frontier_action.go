@@ -0,0 +1,151 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+
+	frontierv1 "go.anx.io/go-anxcloud/pkg/apis/frontier/v1"
+)
+
+func frontierActionHandlerDataSourceSchema(description string, attributes ...string) *schema.Schema {
+	handlerSchema := make(map[string]*schema.Schema, len(attributes))
+	for _, attribute := range attributes {
+		handlerSchema[attribute] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem:        &schema.Resource{Schema: handlerSchema},
+	}
+}
+
+func dataSourceFrontierAction() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves a Frontier action by identifier or by endpoint and HTTP request method.",
+		ReadContext: dataSourceFrontierActionRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Action identifier.",
+				ExactlyOneOf: []string{"id", "http_request_method"},
+			},
+			"http_request_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Action HTTP request method, compared case-insensitively when looking up the action.",
+				ExactlyOneOf: []string{"id", "http_request_method"},
+				RequiredWith: []string{"endpoint"},
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Action endpoint identifier. Required when looking up the action by HTTP request method.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Action type, which is the name of the handler block that is set.",
+			},
+			"url_rewrite":        frontierActionHandlerDataSourceSchema("URL rewrite handler.", "url"),
+			"mock_response":      frontierActionHandlerDataSourceSchema("Mock response handler.", "body", "language"),
+			"e5e_function":       frontierActionHandlerDataSourceSchema("e5e function handler.", "function"),
+			"e5e_async_function": frontierActionHandlerDataSourceSchema("Asynchronous e5e function handler.", "function"),
+			"e5e_async_result":   frontierActionHandlerDataSourceSchema("Asynchronous e5e function result handler.", "function"),
+		},
+	}
+}
+
+func dataSourceFrontierActionRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	action := frontierv1.Action{Identifier: d.Get("id").(string)}
+
+	if action.Identifier == "" {
+		foundAction, err := findFrontierActionByMethod(ctx, a, d.Get("endpoint").(string), d.Get("http_request_method").(string))
+		if err != nil {
+			return diag.Errorf("failed retrieving action by HTTP request method: %s", err)
+		}
+		action = *foundAction
+	} else {
+		if err := a.Get(ctx, &action); err != nil {
+			return diag.Errorf("failed retrieving action by id: %s", err)
+		}
+	}
+
+	d.SetId(action.Identifier)
+
+	var diags diag.Diagnostics
+
+	if err := d.Set("endpoint", action.EndpointIdentifier); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("http_request_method", action.HTTPRequestMethod); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("type", string(action.Type)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set(string(action.Type), flattenFrontierActionMeta(action)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// listFrontierActions returns all actions of an endpoint
+func listFrontierActions(ctx context.Context, a api.API, endpointID string) ([]frontierv1.Action, error) {
+	var channel types.ObjectChannel
+	if err := a.List(ctx, &frontierv1.Action{EndpointIdentifier: endpointID}, api.ObjectChannel(&channel)); err != nil {
+		return nil, fmt.Errorf("failed listing actions: %w", err)
+	}
+
+	var actions []frontierv1.Action
+	for retriever := range channel {
+		var action frontierv1.Action
+		if err := retriever(&action); err != nil {
+			return nil, fmt.Errorf("failed retrieving action: %w", err)
+		}
+
+		if err := a.Get(ctx, &action); err != nil {
+			return nil, fmt.Errorf("failed retrieving full action object: %w", err)
+		}
+
+		if action.EndpointIdentifier != endpointID {
+			continue
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions, nil
+}
+
+func findFrontierActionByMethod(ctx context.Context, a api.API, endpointID, method string) (*frontierv1.Action, error) {
+	actions, err := listFrontierActions(ctx, a, endpointID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range actions {
+		if strings.EqualFold(actions[i].HTTPRequestMethod, method) {
+			return &actions[i], nil
+		}
+	}
+
+	return nil, api.ErrNotFound
+}
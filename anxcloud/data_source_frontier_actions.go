@@ -0,0 +1,73 @@
+package anxcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFrontierActions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of the actions of a Frontier endpoint.",
+		ReadContext: dataSourceFrontierActionsRead,
+		Schema: map[string]*schema.Schema{
+			"endpoint": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Endpoint identifier.",
+			},
+			"actions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of actions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Action identifier.",
+						},
+						"http_request_method": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Action HTTP request method.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Action type.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFrontierActionsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+	endpointID := d.Get("endpoint").(string)
+
+	actions, err := listFrontierActions(ctx, a, endpointID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	actionList := make([]any, 0, len(actions))
+	for _, action := range actions {
+		actionList = append(actionList, map[string]any{
+			"id":                  action.Identifier,
+			"http_request_method": action.HTTPRequestMethod,
+			"type":                string(action.Type),
+		})
+	}
+
+	if err := d.Set("actions", actionList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(endpointID)
+
+	return nil
+}
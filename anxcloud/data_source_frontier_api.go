@@ -0,0 +1,129 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/utils/pointer"
+
+	frontierv1 "go.anx.io/go-anxcloud/pkg/apis/frontier/v1"
+)
+
+func dataSourceFrontierAPI() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves a Frontier API by identifier or name.",
+		ReadContext: dataSourceFrontierAPIRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "API identifier.",
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "API name.",
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API description.",
+			},
+			"transfer_protocol": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API transfer protocol.",
+			},
+		},
+	}
+}
+
+func dataSourceFrontierAPIRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	frontierAPI := frontierv1.API{Identifier: d.Get("id").(string)}
+
+	if frontierAPI.Identifier == "" {
+		foundAPI, err := findFrontierAPIByName(ctx, a, d.Get("name").(string))
+		if err != nil {
+			return diag.Errorf("failed retrieving API by name: %s", err)
+		}
+		frontierAPI = *foundAPI
+	} else {
+		if err := a.Get(ctx, &frontierAPI); err != nil {
+			return diag.Errorf("failed retrieving API by id: %s", err)
+		}
+	}
+
+	d.SetId(frontierAPI.Identifier)
+
+	var diags diag.Diagnostics
+
+	if err := d.Set("name", frontierAPI.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("description", pointer.StringVal(frontierAPI.Description)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("transfer_protocol", frontierAPI.TransferProtocol); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func listFrontierAPIs(ctx context.Context, a api.API) ([]frontierv1.API, error) {
+	var channel types.ObjectChannel
+	if err := a.List(ctx, &frontierv1.API{}, api.ObjectChannel(&channel)); err != nil {
+		return nil, fmt.Errorf("failed listing APIs: %w", err)
+	}
+
+	var apis []frontierv1.API
+	for retriever := range channel {
+		var frontierAPI frontierv1.API
+		if err := retriever(&frontierAPI); err != nil {
+			return nil, fmt.Errorf("failed retrieving API: %w", err)
+		}
+
+		apis = append(apis, frontierAPI)
+	}
+
+	return apis, nil
+}
+
+func findFrontierAPIByName(ctx context.Context, a api.API, name string) (*frontierv1.API, error) {
+	apis, err := listFrontierAPIs(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *frontierv1.API
+	for i := range apis {
+		if apis[i].Name != name {
+			continue
+		}
+
+		if found != nil {
+			return nil, fmt.Errorf("multiple APIs named %q found", name)
+		}
+		found = &apis[i]
+	}
+
+	if found == nil {
+		return nil, api.ErrNotFound
+	}
+
+	if err := a.Get(ctx, found); err != nil {
+		return nil, fmt.Errorf("failed retrieving full API object: %w", err)
+	}
+
+	return found, nil
+}
@@ -0,0 +1,71 @@
+package anxcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFrontierAPIs() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of Frontier APIs.",
+		ReadContext: dataSourceFrontierAPIsRead,
+		Schema: map[string]*schema.Schema{
+			"name_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter APIs by name (partial match).",
+			},
+			"apis": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of APIs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "API identifier.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "API name.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFrontierAPIsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+	nameFilter := d.Get("name_filter").(string)
+
+	apis, err := listFrontierAPIs(ctx, a)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	apiList := make([]any, 0, len(apis))
+	for _, frontierAPI := range apis {
+		if nameFilter != "" && !contains(frontierAPI.Name, nameFilter) {
+			continue
+		}
+
+		apiList = append(apiList, map[string]any{
+			"id":   frontierAPI.Identifier,
+			"name": frontierAPI.Name,
+		})
+	}
+
+	if err := d.Set("apis", apiList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(generateDataSourceID())
+
+	return nil
+}
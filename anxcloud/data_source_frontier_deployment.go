@@ -0,0 +1,153 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+
+	frontierv1 "go.anx.io/go-anxcloud/pkg/apis/frontier/v1"
+)
+
+func dataSourceFrontierDeployment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves a Frontier deployment by identifier or by API and slug.",
+		ReadContext: dataSourceFrontierDeploymentRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Deployment identifier.",
+				ExactlyOneOf: []string{"id", "slug"},
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Deployment slug.",
+				ExactlyOneOf: []string{"id", "slug"},
+				RequiredWith: []string{"api"},
+			},
+			"api": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Deployment API identifier. Required when looking up the deployment by slug.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Deployment name.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Deployment state.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Public URL of the deployed API.",
+			},
+			"endpoint_urls": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Public URLs of the endpoints of the API by path, e.g. `/pets/{id}`.",
+			},
+		},
+	}
+}
+
+func dataSourceFrontierDeploymentRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	deployment := frontierv1.Deployment{Identifier: d.Get("id").(string)}
+
+	if deployment.Identifier == "" {
+		foundDeployment, err := findFrontierDeploymentBySlug(ctx, a, d.Get("api").(string), d.Get("slug").(string))
+		if err != nil {
+			return diag.Errorf("failed retrieving deployment by slug: %s", err)
+		}
+		deployment = *foundDeployment
+	} else {
+		if err := a.Get(ctx, &deployment); err != nil {
+			return diag.Errorf("failed retrieving deployment by id: %s", err)
+		}
+	}
+
+	d.SetId(deployment.Identifier)
+
+	var diags diag.Diagnostics
+
+	if err := d.Set("name", deployment.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("slug", deployment.Slug); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("api", deployment.APIIdentifier); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("state", deployment.State); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("url", frontierDeploymentURL(deployment)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if endpointURLs, err := frontierEndpointURLs(ctx, a, deployment); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else if err := d.Set("endpoint_urls", endpointURLs); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// listFrontierDeployments returns all deployments of an API
+func listFrontierDeployments(ctx context.Context, a api.API, apiID string) ([]frontierv1.Deployment, error) {
+	var channel types.ObjectChannel
+	if err := a.List(ctx, &frontierv1.Deployment{APIIdentifier: apiID}, api.ObjectChannel(&channel)); err != nil {
+		return nil, fmt.Errorf("failed listing deployments: %w", err)
+	}
+
+	var deployments []frontierv1.Deployment
+	for retriever := range channel {
+		var deployment frontierv1.Deployment
+		if err := retriever(&deployment); err != nil {
+			return nil, fmt.Errorf("failed retrieving deployment: %w", err)
+		}
+
+		if err := a.Get(ctx, &deployment); err != nil {
+			return nil, fmt.Errorf("failed retrieving full deployment object: %w", err)
+		}
+
+		if deployment.APIIdentifier != apiID {
+			continue
+		}
+
+		deployments = append(deployments, deployment)
+	}
+
+	return deployments, nil
+}
+
+func findFrontierDeploymentBySlug(ctx context.Context, a api.API, apiID, slug string) (*frontierv1.Deployment, error) {
+	deployments, err := listFrontierDeployments(ctx, a, apiID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range deployments {
+		if deployments[i].Slug == slug {
+			return &deployments[i], nil
+		}
+	}
+
+	return nil, api.ErrNotFound
+}
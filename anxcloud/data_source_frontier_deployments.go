@@ -0,0 +1,85 @@
+package anxcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFrontierDeployments() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of the deployments of a Frontier API.",
+		ReadContext: dataSourceFrontierDeploymentsRead,
+		Schema: map[string]*schema.Schema{
+			"api": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "API identifier.",
+			},
+			"deployments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of deployments.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Deployment identifier.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Deployment name.",
+						},
+						"slug": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Deployment slug.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Deployment state.",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Public URL of the deployed API.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFrontierDeploymentsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+	apiID := d.Get("api").(string)
+
+	deployments, err := listFrontierDeployments(ctx, a, apiID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentList := make([]any, 0, len(deployments))
+	for _, deployment := range deployments {
+		deploymentList = append(deploymentList, map[string]any{
+			"id":    deployment.Identifier,
+			"name":  deployment.Name,
+			"slug":  deployment.Slug,
+			"state": deployment.State,
+			"url":   frontierDeploymentURL(deployment),
+		})
+	}
+
+	if err := d.Set("deployments", deploymentList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(apiID)
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+
+	frontierv1 "go.anx.io/go-anxcloud/pkg/apis/frontier/v1"
+)
+
+func dataSourceFrontierEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves a Frontier endpoint by identifier or by API and path.",
+		ReadContext: dataSourceFrontierEndpointRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Endpoint identifier.",
+				ExactlyOneOf: []string{"id", "path"},
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Endpoint path. A leading slash is ignored when looking up the endpoint.",
+				ExactlyOneOf: []string{"id", "path"},
+				RequiredWith: []string{"api"},
+			},
+			"api": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Endpoint API identifier. Required when looking up the endpoint by path.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Endpoint name.",
+			},
+		},
+	}
+}
+
+func dataSourceFrontierEndpointRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	endpoint := frontierv1.Endpoint{Identifier: d.Get("id").(string)}
+
+	if endpoint.Identifier == "" {
+		foundEndpoint, err := findFrontierEndpointByPath(ctx, a, d.Get("api").(string), d.Get("path").(string))
+		if err != nil {
+			return diag.Errorf("failed retrieving endpoint by path: %s", err)
+		}
+		endpoint = *foundEndpoint
+	} else {
+		if err := a.Get(ctx, &endpoint); err != nil {
+			return diag.Errorf("failed retrieving endpoint by id: %s", err)
+		}
+	}
+
+	d.SetId(endpoint.Identifier)
+
+	var diags diag.Diagnostics
+
+	if err := d.Set("name", endpoint.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("path", endpoint.Path); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("api", endpoint.APIIdentifier); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// listFrontierEndpoints returns all endpoints of an API
+func listFrontierEndpoints(ctx context.Context, a api.API, apiID string) ([]frontierv1.Endpoint, error) {
+	var channel types.ObjectChannel
+	if err := a.List(ctx, &frontierv1.Endpoint{APIIdentifier: apiID}, api.ObjectChannel(&channel)); err != nil {
+		return nil, fmt.Errorf("failed listing endpoints: %w", err)
+	}
+
+	var endpoints []frontierv1.Endpoint
+	for retriever := range channel {
+		var endpoint frontierv1.Endpoint
+		if err := retriever(&endpoint); err != nil {
+			return nil, fmt.Errorf("failed retrieving endpoint: %w", err)
+		}
+
+		if err := a.Get(ctx, &endpoint); err != nil {
+			return nil, fmt.Errorf("failed retrieving full endpoint object: %w", err)
+		}
+
+		if endpoint.APIIdentifier != apiID {
+			continue
+		}
+
+		endpoints = append(endpoints, endpoint)
+	}
+
+	return endpoints, nil
+}
+
+func findFrontierEndpointByPath(ctx context.Context, a api.API, apiID, path string) (*frontierv1.Endpoint, error) {
+	endpoints, err := listFrontierEndpoints(ctx, a, apiID)
+	if err != nil {
+		return nil, err
+	}
+
+	path = strings.TrimPrefix(path, "/")
+	for i := range endpoints {
+		if strings.TrimPrefix(endpoints[i].Path, "/") == path {
+			return &endpoints[i], nil
+		}
+	}
+
+	return nil, api.ErrNotFound
+}
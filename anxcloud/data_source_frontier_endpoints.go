@@ -0,0 +1,73 @@
+package anxcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFrontierEndpoints() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of the endpoints of a Frontier API.",
+		ReadContext: dataSourceFrontierEndpointsRead,
+		Schema: map[string]*schema.Schema{
+			"api": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "API identifier.",
+			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of endpoints.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Endpoint identifier.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Endpoint name.",
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Endpoint path.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFrontierEndpointsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+	apiID := d.Get("api").(string)
+
+	endpoints, err := listFrontierEndpoints(ctx, a, apiID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	endpointList := make([]any, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		endpointList = append(endpointList, map[string]any{
+			"id":   endpoint.Identifier,
+			"name": endpoint.Name,
+			"path": endpoint.Path,
+		})
+	}
+
+	if err := d.Set("endpoints", endpointList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(apiID)
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package anxcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/testutils/environment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAnxCloudFrontierDataSources(t *testing.T) {
+	environment.SkipIfNoEnvironment(t)
+
+	runName := environment.GetEnvInfo(t).TestRunName
+
+	config := fmt.Sprintf(`
+	resource "anxcloud_frontier_api" "foo" {
+		name = "terraform-test-data-sources-%[1]s"
+		transfer_protocol = "http"
+	}
+
+	resource "anxcloud_frontier_endpoint" "foo" {
+		name = "terraform-test-data-sources-%[1]s"
+		path = "foo"
+		api = anxcloud_frontier_api.foo.id
+	}
+
+	resource "anxcloud_frontier_action" "foo" {
+		http_request_method = "get"
+		endpoint = anxcloud_frontier_endpoint.foo.id
+
+		mock_response {
+			body = "foo"
+			language = "plaintext"
+		}
+	}
+
+	resource "anxcloud_frontier_deployment" "foo" {
+		slug = "foo"
+		api = anxcloud_frontier_api.foo.id
+
+		depends_on = [
+			anxcloud_frontier_action.foo
+		]
+	}
+
+	data "anxcloud_frontier_api" "foo" {
+		name = anxcloud_frontier_api.foo.name
+	}
+
+	data "anxcloud_frontier_apis" "foo" {
+		name_filter = "terraform-test-data-sources-%[1]s"
+		depends_on  = [anxcloud_frontier_api.foo]
+	}
+
+	data "anxcloud_frontier_endpoint" "foo" {
+		api  = anxcloud_frontier_api.foo.id
+		path = "/foo"
+
+		depends_on = [anxcloud_frontier_endpoint.foo]
+	}
+
+	data "anxcloud_frontier_endpoints" "foo" {
+		api = anxcloud_frontier_endpoint.foo.api
+	}
+
+	data "anxcloud_frontier_action" "foo" {
+		endpoint            = anxcloud_frontier_endpoint.foo.id
+		http_request_method = "GET"
+
+		depends_on = [anxcloud_frontier_action.foo]
+	}
+
+	data "anxcloud_frontier_actions" "foo" {
+		endpoint = anxcloud_frontier_action.foo.endpoint
+	}
+
+	data "anxcloud_frontier_deployment" "foo" {
+		api  = anxcloud_frontier_api.foo.id
+		slug = anxcloud_frontier_deployment.foo.slug
+	}
+
+	data "anxcloud_frontier_deployments" "foo" {
+		api = anxcloud_frontier_deployment.foo.api
+	}
+	`, runName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.anxcloud_frontier_api.foo", "id", "anxcloud_frontier_api.foo", "id"),
+					resource.TestCheckResourceAttr("data.anxcloud_frontier_apis.foo", "apis.#", "1"),
+					resource.TestCheckResourceAttrPair("data.anxcloud_frontier_endpoint.foo", "id", "anxcloud_frontier_endpoint.foo", "id"),
+					resource.TestCheckResourceAttr("data.anxcloud_frontier_endpoints.foo", "endpoints.#", "1"),
+					resource.TestCheckResourceAttrPair("data.anxcloud_frontier_action.foo", "id", "anxcloud_frontier_action.foo", "id"),
+					resource.TestCheckResourceAttr("data.anxcloud_frontier_action.foo", "type", "mock_response"),
+					resource.TestCheckResourceAttr("data.anxcloud_frontier_action.foo", "mock_response.0.body", "foo"),
+					resource.TestCheckResourceAttr("data.anxcloud_frontier_actions.foo", "actions.#", "1"),
+					resource.TestCheckResourceAttrPair("data.anxcloud_frontier_deployment.foo", "id", "anxcloud_frontier_deployment.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.anxcloud_frontier_deployment.foo", "url", "anxcloud_frontier_deployment.foo", "url"),
+					resource.TestCheckResourceAttrPair("data.anxcloud_frontier_deployment.foo", "endpoint_urls./foo", "anxcloud_frontier_deployment.foo", "endpoint_urls./foo"),
+					resource.TestCheckResourceAttr("data.anxcloud_frontier_deployments.foo", "deployments.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,110 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/ipam/prefix"
+)
+
+func dataSourceNetworkPrefix() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides details about an Anexia Cloud network prefix. This data source is useful if you want to use a non-terraform managed network prefix.",
+		ReadContext: dataSourceNetworkPrefixRead,
+		Schema: map[string]*schema.Schema{
+			"id":   {Type: schema.TypeString, Optional: true, Computed: true, Description: identifierDescription, ExactlyOneOf: []string{"id", "cidr"}},
+			"cidr": {Type: schema.TypeString, Optional: true, Computed: true, Description: "CIDR of the prefix."},
+
+			"location_id":          {Type: schema.TypeString, Computed: true, Description: "Identifier of the location of the prefix."},
+			"vlan_id":              {Type: schema.TypeString, Computed: true, Description: "The corresponding VLAN identifier."},
+			"netmask":              {Type: schema.TypeInt, Computed: true, Description: "Netmask size."},
+			"ip_version":           {Type: schema.TypeInt, Computed: true, Description: "The Prefix version: 4 = IPv4, 6 = IPv6."},
+			"type":                 {Type: schema.TypeInt, Computed: true, Description: "The Prefix type: 0 = Public, 1 = Private."},
+			"router_redundancy":    {Type: schema.TypeBool, Computed: true, Description: "If router Redundancy is enabled."},
+			"description_customer": {Type: schema.TypeString, Computed: true, Description: "Additional customer description."},
+			"description_internal": {Type: schema.TypeString, Computed: true, Description: "Internal description."},
+			"role_text":            {Type: schema.TypeString, Computed: true, Description: "Role of the prefix."},
+			"status":               {Type: schema.TypeString, Computed: true, Description: "Status of the prefix."},
+			"locations":            schemaLocations(),
+		},
+	}
+}
+
+func dataSourceNetworkPrefixRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(providerContext).legacyClient
+	p := prefix.NewAPI(c)
+
+	var (
+		id, _   = d.Get("id").(string)
+		cidr, _ = d.Get("cidr").(string)
+	)
+
+	switch {
+	case id != "":
+	case cidr != "":
+		var diags diag.Diagnostics
+		if id, diags = findNetworkPrefixByCIDR(ctx, p, cidr); diags.HasError() {
+			return diags
+		}
+	default:
+		return diag.Errorf("Either provide a non-empty %q or %q to query a network prefix.", "id", "cidr")
+	}
+
+	info, err := p.Get(ctx, id)
+	if err != nil {
+		if err := handleNotFoundError(err); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return diag.Errorf(
+			`No network prefix with the given identifier %q could be found.
+If you are sure that it exists, verify that you have the correct permissions to access it.`, id)
+	}
+
+	d.SetId(info.ID)
+	return networkPrefixIntoResourceData(info, d)
+}
+
+// findNetworkPrefixByCIDR searches for a given network prefix by its CIDR.
+func findNetworkPrefixByCIDR(ctx context.Context, p prefix.API, cidr string) (string, diag.Diagnostics) {
+	// Parse CIDR to normalize it (engine uses shortened, lowercase IPv6 prefix names)
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", diag.Errorf("Failed to parse CIDR %q: %s", cidr, err)
+	}
+	cidr = network.String()
+
+	prefixes, err := listAllPages(func(page int) ([]prefix.Summary, error) {
+		return p.List(ctx, page, 100)
+	})
+	if err != nil {
+		return "", diag.FromErr(fmt.Errorf("querying network prefix with CIDR %q from engine: %w", cidr, err))
+	}
+
+	var foundID string
+	for _, summary := range prefixes {
+		if summary.Name != cidr {
+			continue
+		}
+
+		// private prefixes might exist multiple times in separate VLANs
+		if foundID != "" {
+			return "", diag.Errorf("CIDR ambiguity detected when searching for network prefix %q. You should reference the network prefix using one of its identifiers (%s) instead of relying on the CIDR.",
+				cidr,
+				strings.Join([]string{foundID, summary.ID}, ", "))
+		}
+
+		foundID = summary.ID
+	}
+
+	if foundID == "" {
+		return "", diag.Errorf(`No network prefix found with the CIDR %q.
+If you are sure that it exists, verify that you have the correct permissions to access it.`, cidr)
+	}
+
+	return foundID, nil
+}
@@ -0,0 +1,112 @@
+package anxcloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/testutils/environment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"go.anx.io/go-anxcloud/pkg/ipam/prefix"
+)
+
+func TestAccAnxCloudNetworkPrefixDataSource(t *testing.T) {
+	environment.SkipIfNoEnvironment(t)
+
+	envInfo := environment.GetEnvInfo(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			// expected to fail
+			{
+				Config:      `data "anxcloud_network_prefix" "foo" { id = "not_found" }`,
+				ExpectError: regexp.MustCompile(`No network prefix with the given identifier "not_found" could be found.`),
+			},
+			{
+				Config:      `data "anxcloud_network_prefix" "foo" { cidr = "not a cidr" }`,
+				ExpectError: regexp.MustCompile(`Failed to parse CIDR`),
+			},
+			{
+				Config:      `data "anxcloud_network_prefix" "foo" {}`,
+				ExpectError: regexp.MustCompile("one of `cidr,id` must be specified"),
+			},
+
+			// expected to succeed
+			{
+				Config: fmt.Sprintf(`data "anxcloud_network_prefix" "foo" { id = %q }`, envInfo.Prefix.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.anxcloud_network_prefix.foo", "status", "Active"),
+					resource.TestCheckResourceAttr("data.anxcloud_network_prefix.foo", "vlan_id", envInfo.VlanID),
+					resource.TestCheckResourceAttr("data.anxcloud_network_prefix.foo", "ip_version", "4"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`data "anxcloud_network_prefix" "foo" { cidr = %q }`, envInfo.Prefix.CIDR.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.anxcloud_network_prefix.foo", "id", envInfo.Prefix.ID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAnxCloudNetworkPrefixesDataSource(t *testing.T) {
+	environment.SkipIfNoEnvironment(t)
+
+	envInfo := environment.GetEnvInfo(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "anxcloud_network_prefixes" "foo" {
+					vlan_id    = %q
+					ip_version = 4
+				}
+				`, envInfo.VlanID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.anxcloud_network_prefixes.foo", "prefixes.*", map[string]string{
+						"identifier": envInfo.Prefix.ID,
+						"vlan_id":    envInfo.VlanID,
+						"ip_version": "4",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestNetworkPrefixFromSummary(t *testing.T) {
+	cases := []struct {
+		CIDR              string
+		ExpectedIPVersion int
+		ExpectedNetmask   int
+		ExpectError       bool
+	}{
+		{"10.0.0.0/24", 4, 24, false},
+		{"2001:db8::/64", 6, 64, false},
+		{"not-a-cidr", 0, 0, true},
+	}
+
+	for _, tc := range cases {
+		info, err := networkPrefixFromSummary(prefix.Summary{ID: "prefix-id", Name: tc.CIDR})
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("expected an error for %q", tc.CIDR)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if info.ID != "prefix-id" || info.Name != tc.CIDR || info.IPVersion != tc.ExpectedIPVersion || info.NetworkMask != tc.ExpectedNetmask {
+			t.Errorf("unexpected prefix for %q: %+v", tc.CIDR, info)
+		}
+	}
+}
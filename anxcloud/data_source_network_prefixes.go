@@ -0,0 +1,122 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/ipam/prefix"
+)
+
+func dataSourceNetworkPrefixes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides available network prefixes, optionally filtered by location, VLAN, IP version and status.",
+		ReadContext: dataSourceNetworkPrefixesRead,
+		Schema:      schemaNetworkPrefixes(),
+	}
+}
+
+func dataSourceNetworkPrefixesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(providerContext).legacyClient
+	p := prefix.NewAPI(c)
+
+	summaries, err := listAllPages(func(page int) ([]prefix.Summary, error) {
+		return p.List(ctx, page, 100)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the list endpoint doesn't support filtering and only returns a summary, the details are
+	// retrieved with a request per prefix which isn't already excluded by its summary
+	prefixes := make([]prefix.Info, 0, len(summaries))
+	for _, summary := range summaries {
+		info, err := networkPrefixFromSummary(summary)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if !networkPrefixMatchesFilters(info, d) {
+			continue
+		}
+
+		if info, err = p.Get(ctx, summary.ID); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if !networkPrefixMatchesDetailFilters(info, d) {
+			continue
+		}
+
+		prefixes = append(prefixes, info)
+	}
+
+	if err := d.Set("prefixes", flattenNetworkPrefixes(prefixes)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Round(time.Hour).Unix(), 10))
+
+	return nil
+}
+
+// networkPrefixFromSummary returns the prefix with the fields derived from the listed summary
+func networkPrefixFromSummary(summary prefix.Summary) (prefix.Info, error) {
+	cidr, err := netip.ParsePrefix(summary.Name)
+	if err != nil {
+		return prefix.Info{}, fmt.Errorf("failed parsing CIDR of prefix %q: %w", summary.ID, err)
+	}
+
+	info := prefix.Info{
+		ID:          summary.ID,
+		Name:        summary.Name,
+		IPVersion:   4,
+		NetworkMask: cidr.Bits(),
+	}
+	if cidr.Addr().Is6() {
+		info.IPVersion = 6
+	}
+
+	return info, nil
+}
+
+// networkPrefixMatchesFilters checks the filters which don't require the details of a prefix
+func networkPrefixMatchesFilters(info prefix.Info, d *schema.ResourceData) bool {
+	if ipVersion, ok := d.GetOk("ip_version"); ok && info.IPVersion != ipVersion.(int) {
+		return false
+	}
+
+	return true
+}
+
+// networkPrefixMatchesDetailFilters checks the filters which require the details of a prefix
+func networkPrefixMatchesDetailFilters(info prefix.Info, d *schema.ResourceData) bool {
+	if status, ok := d.GetOk("status"); ok && info.Status != status.(string) {
+		return false
+	}
+	if locationID, ok := d.GetOk("location_id"); ok {
+		found := false
+		for _, l := range info.Locations {
+			found = found || l.ID == locationID.(string)
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if vlanID, ok := d.GetOk("vlan_id"); ok {
+		found := false
+		for _, v := range info.Vlans {
+			found = found || v.ID == vlanID.(string)
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
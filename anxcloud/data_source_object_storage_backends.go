@@ -2,7 +2,6 @@ package anxcloud
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -20,12 +19,7 @@ func dataSourceObjectStorageBackends() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Read: schema.DefaultTimeout(1 * time.Minute),
 		},
-		Schema: map[string]*schema.Schema{
-			"name_filter": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Filter backends by name (partial match).",
-			},
+		Schema: mergeSchemas(schemaObjectStorageFilters("backends", objectStorageBackendFilters...), map[string]*schema.Schema{
 			"enabled_only": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -81,21 +75,24 @@ func dataSourceObjectStorageBackends() *schema.Resource {
 						"customer": {
 							Type:        schema.TypeString,
 							Computed:    true,
-							Description: "Customer identifier.",
+							Description: "Customer name, the API returns the name instead of the identifier.",
 						},
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
+// objectStorageBackendFilters are the references backends can be filtered by
+var objectStorageBackendFilters = []string{"customer", "endpoint"}
+
 func dataSourceObjectStorageBackendsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	a := apiFromProviderConfig(m)
 
 	// Get configuration
-	nameFilter := d.Get("name_filter").(string)
+	filter := expandObjectStorageFilter(d, objectStorageBackendFilters...)
 	enabledOnly := d.Get("enabled_only").(bool)
 
 	// Get list of backends
@@ -117,17 +114,12 @@ func dataSourceObjectStorageBackendsRead(ctx context.Context, d *schema.Resource
 
 	// Filter backends
 	var filteredBackends []objectstoragev2.S3Backend
-	for _, backend := range backends {
+	for _, backend := range filterObjectStorage(backends, filter) {
 		// Filter by enabled status if requested
 		if enabledOnly && (backend.Enabled == nil || !*backend.Enabled) {
 			continue
 		}
 
-		// Filter by name if provided
-		if nameFilter != "" && !contains(backend.Name, nameFilter) {
-			continue
-		}
-
 		filteredBackends = append(filteredBackends, backend)
 	}
 
@@ -174,16 +166,3 @@ func dataSourceObjectStorageBackendsRead(ctx context.Context, d *schema.Resource
 
 	return diags
 }
-
-// Helper function to check if a string contains a substring (case-insensitive)
-func contains(s, substr string) bool {
-	return len(substr) == 0 || len(s) >= len(substr) &&
-		(s == substr || stringContainsIgnoreCase(s, substr))
-}
-
-func stringContainsIgnoreCase(s, substr string) bool {
-	// Simple case-insensitive contains check
-	sLower := strings.ToLower(s)
-	substrLower := strings.ToLower(substr)
-	return strings.Contains(sLower, substrLower)
-}
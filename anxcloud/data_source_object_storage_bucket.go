@@ -0,0 +1,67 @@
+package anxcloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+)
+
+func dataSourceObjectStorageBucket() *schema.Resource {
+	bucketSchema := mergeSchemas(
+		schemaObjectStorageBucketDataSource(),
+		schemaObjectStorageReferenceFilters("buckets", objectStorageBucketFilters...),
+	)
+
+	bucketSchema["identifier"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"identifier", "name"},
+		Description:  "The identifier of the bucket.",
+	}
+	bucketSchema["name"].Optional = true
+	bucketSchema["name"].Description += " Matched exactly, the filters select among buckets with the same name."
+
+	return &schema.Resource{
+		Description: "Retrieves an Object Storage bucket by identifier or name.",
+		ReadContext: dataSourceObjectStorageBucketRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
+		Schema: bucketSchema,
+	}
+}
+
+func dataSourceObjectStorageBucketRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	a := apiFromProviderConfig(m)
+
+	bucket := objectstoragev2.Bucket{Identifier: d.Get("identifier").(string)}
+
+	if bucket.Identifier == "" {
+		buckets, err := listObjectStorageBuckets(ctx, a)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		found, err := findObjectStorageByName(buckets, d.Get("name").(string), expandObjectStorageReferenceFilter(d, objectStorageBucketFilters...))
+		if err != nil {
+			return diag.Errorf("failed retrieving bucket by name: %s", err)
+		}
+		bucket = *found
+	}
+
+	if err := a.Get(ctx, &bucket); err != nil {
+		return diag.Errorf("failed retrieving bucket: %s", err)
+	}
+
+	d.SetId(bucket.Identifier)
+
+	setObjectStorageDataSourceFields(d, flattenObjectStorageBucket(&bucket), &diags)
+
+	return diags
+}
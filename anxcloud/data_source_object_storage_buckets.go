@@ -0,0 +1,176 @@
+package anxcloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+)
+
+func dataSourceObjectStorageBuckets() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of Object Storage buckets.",
+		ReadContext: dataSourceObjectStorageBucketsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
+		Schema: mergeSchemas(schemaObjectStorageFilters("buckets", objectStorageBucketFilters...), map[string]*schema.Schema{
+			"buckets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of buckets.",
+				Elem: &schema.Resource{
+					Schema: mergeSchemas(schemaObjectStorageBucketDataSource(), map[string]*schema.Schema{
+						"identifier": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the bucket.",
+						},
+					}),
+				},
+			},
+		}),
+	}
+}
+
+// objectStorageBucketFilters are the references buckets can be filtered by
+var objectStorageBucketFilters = []string{"customer", "backend", "region", "tenant"}
+
+// schemaObjectStorageBucketDataSource returns the computed attributes of buckets in data sources
+func schemaObjectStorageBucketDataSource() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the bucket, including suffixes generated by the backend.",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Identifier of the region of the bucket.",
+		},
+		"backend": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Identifier of the S3 backend of the bucket.",
+		},
+		"tenant": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Identifier of the tenant owning the bucket.",
+		},
+		"object_count": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Number of objects in the bucket.",
+		},
+		"object_size": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Collective size of objects in the bucket.",
+		},
+		"object_lock_lifetime_in_days": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of days for object lock lifetime.",
+		},
+		"versioning_active": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Indicates whether versioning is enabled for objects in the bucket.",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "State of the bucket.",
+		},
+		"reseller": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Reseller identifier.",
+		},
+		"customer": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Customer name, the API returns the name instead of the identifier.",
+		},
+	}
+}
+
+func dataSourceObjectStorageBucketsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	buckets, err := listObjectStorageBuckets(ctx, a)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filtered := filterObjectStorage(buckets, expandObjectStorageFilter(d, objectStorageBucketFilters...))
+
+	bucketList := make([]interface{}, 0, len(filtered))
+	for i := range filtered {
+		bucketList = append(bucketList, flattenObjectStorageBucket(&filtered[i]))
+	}
+
+	if err := d.Set("buckets", bucketList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Set ID based on time to make resource unique
+	d.SetId(generateDataSourceID())
+
+	return nil
+}
+
+func listObjectStorageBuckets(ctx context.Context, a api.API) ([]objectstoragev2.Bucket, error) {
+	var pageIter types.PageInfo
+	if err := a.List(ctx, &objectstoragev2.Bucket{}, api.Paged(1, 100, &pageIter)); err != nil {
+		return nil, err
+	}
+
+	buckets := make([]objectstoragev2.Bucket, 0, pageIter.TotalItems())
+	var pagedBuckets []objectstoragev2.Bucket
+	for pageIter.Next(&pagedBuckets) {
+		buckets = append(buckets, pagedBuckets...)
+	}
+
+	if err := pageIter.Error(); err != nil {
+		return nil, err
+	}
+
+	return buckets, nil
+}
+
+func flattenObjectStorageBucket(bucket *objectstoragev2.Bucket) map[string]interface{} {
+	fields := map[string]interface{}{
+		"identifier":        bucket.Identifier,
+		"name":              bucket.Name,
+		"region":            bucket.Region.Identifier,
+		"backend":           bucket.Backend.Identifier,
+		"tenant":            bucket.Tenant.Identifier,
+		"versioning_active": bucket.VersioningActive,
+		"reseller":          bucket.Reseller,
+		"customer":          bucket.Customer,
+	}
+
+	if bucket.ObjectLockLifetime != nil {
+		fields["object_lock_lifetime_in_days"] = *bucket.ObjectLockLifetime
+	}
+	if objectCount, err := bucket.GetObjectCount(); err == nil {
+		fields["object_count"] = objectCount
+	}
+	if objectSize, err := bucket.GetObjectSize(); err == nil {
+		fields["object_size"] = objectSize
+	}
+
+	if bucket.State != nil {
+		fields["state"] = bucket.State.String()
+	}
+
+	return fields
+}
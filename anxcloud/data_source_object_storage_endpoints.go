@@ -35,7 +35,12 @@ func dataSourceObjectStorageEndpoints() *schema.Resource {
 			"search": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Search term to filter endpoints.",
+				Description: "Filter endpoints by URL (partial match).",
+			},
+			"customer_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: objectStorageCustomerFilterDescription("endpoints"),
 			},
 			"enabled_only": {
 				Type:        schema.TypeBool,
@@ -82,7 +87,7 @@ func dataSourceObjectStorageEndpoints() *schema.Resource {
 						"customer": {
 							Type:        schema.TypeString,
 							Computed:    true,
-							Description: "Customer identifier.",
+							Description: "Customer name, the API returns the name instead of the identifier.",
 						},
 						"created_at": {
 							Type:        schema.TypeString,
@@ -107,6 +112,10 @@ func dataSourceObjectStorageEndpointsRead(ctx context.Context, d *schema.Resourc
 
 	// Get configuration
 	enabledOnly := d.Get("enabled_only").(bool)
+	filter := objectStorageFilter{
+		"name":     d.Get("search").(string),
+		"customer": d.Get("customer_filter").(string),
+	}
 
 	// Get list of endpoints without any filtering first
 	var pageIter types.PageInfo
@@ -128,13 +137,13 @@ func dataSourceObjectStorageEndpointsRead(ctx context.Context, d *schema.Resourc
 	// Filter endpoints based on enabled status if requested
 	var filteredEndpoints []objectstoragev2.Endpoint
 	if enabledOnly {
-		for _, endpoint := range endpoints {
+		for _, endpoint := range filterObjectStorage(endpoints, filter) {
 			if endpoint.Enabled {
 				filteredEndpoints = append(filteredEndpoints, endpoint)
 			}
 		}
 	} else {
-		filteredEndpoints = endpoints
+		filteredEndpoints = filterObjectStorage(endpoints, filter)
 	}
 
 	// Convert to terraform data structure
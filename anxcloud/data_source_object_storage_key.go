@@ -0,0 +1,67 @@
+package anxcloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+)
+
+func dataSourceObjectStorageKey() *schema.Resource {
+	keySchema := mergeSchemas(
+		schemaObjectStorageKeyDataSource(),
+		schemaObjectStorageReferenceFilters("keys", objectStorageKeyFilters...),
+	)
+
+	keySchema["identifier"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"identifier", "name"},
+		Description:  "The identifier of the key.",
+	}
+	keySchema["name"].Optional = true
+	keySchema["name"].Description += " Matched exactly, the filters select among keys with the same name."
+
+	return &schema.Resource{
+		Description: "Retrieves an Object Storage key by identifier or name.",
+		ReadContext: dataSourceObjectStorageKeyRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
+		Schema: keySchema,
+	}
+}
+
+func dataSourceObjectStorageKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	a := apiFromProviderConfig(m)
+
+	key := objectstoragev2.Key{Identifier: d.Get("identifier").(string)}
+
+	if key.Identifier == "" {
+		keys, err := listObjectStorageKeys(ctx, a)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		found, err := findObjectStorageByName(keys, d.Get("name").(string), expandObjectStorageReferenceFilter(d, objectStorageKeyFilters...))
+		if err != nil {
+			return diag.Errorf("failed retrieving key by name: %s", err)
+		}
+		key = *found
+	}
+
+	if err := a.Get(ctx, &key); err != nil {
+		return diag.Errorf("failed retrieving key: %s", err)
+	}
+
+	d.SetId(key.Identifier)
+
+	setObjectStorageDataSourceFields(d, flattenObjectStorageKey(&key), &diags)
+
+	return diags
+}
@@ -0,0 +1,168 @@
+package anxcloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+)
+
+func dataSourceObjectStorageKeys() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of Object Storage keys.",
+		ReadContext: dataSourceObjectStorageKeysRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
+		Schema: mergeSchemas(schemaObjectStorageFilters("keys", objectStorageKeyFilters...), map[string]*schema.Schema{
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of keys.",
+				Elem: &schema.Resource{
+					Schema: mergeSchemas(schemaObjectStorageKeyDataSource(), map[string]*schema.Schema{
+						"identifier": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the key.",
+						},
+					}),
+				},
+			},
+		}),
+	}
+}
+
+// objectStorageKeyFilters are the references keys can be filtered by
+var objectStorageKeyFilters = []string{"customer", "backend", "tenant", "user"}
+
+// schemaObjectStorageKeyDataSource returns the computed attributes of keys in data sources
+func schemaObjectStorageKeyDataSource() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the key.",
+		},
+		"backend": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Identifier of the S3 backend this key belongs to.",
+		},
+		"tenant": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Identifier of the tenant this key belongs to.",
+		},
+		"user": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Identifier of the user this key belongs to.",
+		},
+		"expire_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Expiration date of the key in RFC3339 format.",
+		},
+		"remote_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Remote identifier of the key.",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "State of the key.",
+		},
+		"reseller": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Reseller identifier.",
+		},
+		"customer": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Customer name, the API returns the name instead of the identifier.",
+		},
+	}
+}
+
+func dataSourceObjectStorageKeysRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	keys, err := listObjectStorageKeys(ctx, a)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filtered := filterObjectStorage(keys, expandObjectStorageFilter(d, objectStorageKeyFilters...))
+
+	keyList := make([]interface{}, 0, len(filtered))
+	for i := range filtered {
+		keyList = append(keyList, flattenObjectStorageKey(&filtered[i]))
+	}
+
+	if err := d.Set("keys", keyList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Set ID based on time to make resource unique
+	d.SetId(generateDataSourceID())
+
+	return nil
+}
+
+func listObjectStorageKeys(ctx context.Context, a api.API) ([]objectstoragev2.Key, error) {
+	var pageIter types.PageInfo
+	if err := a.List(ctx, &objectstoragev2.Key{}, api.Paged(1, 100, &pageIter)); err != nil {
+		return nil, err
+	}
+
+	keys := make([]objectstoragev2.Key, 0, pageIter.TotalItems())
+	var pagedKeys []objectstoragev2.Key
+	for pageIter.Next(&pagedKeys) {
+		keys = append(keys, pagedKeys...)
+	}
+
+	if err := pageIter.Error(); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func flattenObjectStorageKey(key *objectstoragev2.Key) map[string]interface{} {
+	fields := map[string]interface{}{
+		"identifier": key.Identifier,
+		"name":       key.Name,
+		"reseller":   key.Reseller,
+		"customer":   key.Customer,
+	}
+
+	if key.Backend != nil {
+		fields["backend"] = key.Backend.Identifier
+	}
+	if key.Tenant != nil {
+		fields["tenant"] = key.Tenant.Identifier
+	}
+	if key.User != nil {
+		fields["user"] = key.User.Identifier
+	}
+	if key.ExpireDate != nil {
+		fields["expire_date"] = key.ExpireDate.Format(time.RFC3339)
+	}
+	if key.RemoteID != nil {
+		fields["remote_id"] = *key.RemoteID
+	}
+
+	if key.State != nil {
+		fields["state"] = key.State.String()
+	}
+
+	return fields
+}
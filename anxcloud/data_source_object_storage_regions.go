@@ -19,17 +19,7 @@ func dataSourceObjectStorageRegions() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Read: schema.DefaultTimeout(1 * time.Minute),
 		},
-		Schema: map[string]*schema.Schema{
-			"name_filter": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Filter regions by name (partial match).",
-			},
-			"backend_filter": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Filter regions by backend identifier.",
-			},
+		Schema: mergeSchemas(schemaObjectStorageFilters("regions", objectStorageRegionFilters...), map[string]*schema.Schema{
 			"regions": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -69,22 +59,24 @@ func dataSourceObjectStorageRegions() *schema.Resource {
 						"customer": {
 							Type:        schema.TypeString,
 							Computed:    true,
-							Description: "Customer identifier.",
+							Description: "Customer name, the API returns the name instead of the identifier.",
 						},
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
+// objectStorageRegionFilters are the references regions can be filtered by
+var objectStorageRegionFilters = []string{"customer", "backend"}
+
 func dataSourceObjectStorageRegionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	a := apiFromProviderConfig(m)
 
 	// Get configuration
-	nameFilter := d.Get("name_filter").(string)
-	backendFilter := d.Get("backend_filter").(string)
+	filter := expandObjectStorageFilter(d, objectStorageRegionFilters...)
 
 	// Get list of regions
 	var pageIter types.PageInfo
@@ -104,20 +96,7 @@ func dataSourceObjectStorageRegionsRead(ctx context.Context, d *schema.ResourceD
 	}
 
 	// Filter regions
-	var filteredRegions []objectstoragev2.Region
-	for _, region := range regions {
-		// Filter by backend if provided
-		if backendFilter != "" && (region.Backend == nil || region.Backend.Identifier != backendFilter) {
-			continue
-		}
-
-		// Filter by name if provided
-		if nameFilter != "" && !contains(region.Name, nameFilter) {
-			continue
-		}
-
-		filteredRegions = append(filteredRegions, region)
-	}
+	filteredRegions := filterObjectStorage(regions, filter)
 
 	// Convert to terraform data structure
 	regionList := make([]interface{}, len(filteredRegions))
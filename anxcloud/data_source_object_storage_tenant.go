@@ -0,0 +1,67 @@
+package anxcloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+)
+
+func dataSourceObjectStorageTenant() *schema.Resource {
+	tenantSchema := mergeSchemas(
+		schemaObjectStorageTenantDataSource(),
+		schemaObjectStorageReferenceFilters("tenants", objectStorageTenantFilters...),
+	)
+
+	tenantSchema["identifier"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"identifier", "name"},
+		Description:  "The identifier of the tenant.",
+	}
+	tenantSchema["name"].Optional = true
+	tenantSchema["name"].Description += " Matched exactly, the filters select among tenants with the same name."
+
+	return &schema.Resource{
+		Description: "Retrieves an Object Storage tenant by identifier or name.",
+		ReadContext: dataSourceObjectStorageTenantRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
+		Schema: tenantSchema,
+	}
+}
+
+func dataSourceObjectStorageTenantRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	a := apiFromProviderConfig(m)
+
+	tenant := objectstoragev2.Tenant{Identifier: d.Get("identifier").(string)}
+
+	if tenant.Identifier == "" {
+		tenants, err := listObjectStorageTenants(ctx, a)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		found, err := findObjectStorageByName(tenants, d.Get("name").(string), expandObjectStorageReferenceFilter(d, objectStorageTenantFilters...))
+		if err != nil {
+			return diag.Errorf("failed retrieving tenant by name: %s", err)
+		}
+		tenant = *found
+	}
+
+	if err := a.Get(ctx, &tenant); err != nil {
+		return diag.Errorf("failed retrieving tenant: %s", err)
+	}
+
+	d.SetId(tenant.Identifier)
+
+	setObjectStorageDataSourceFields(d, flattenObjectStorageTenant(&tenant), &diags)
+
+	return diags
+}
@@ -0,0 +1,170 @@
+package anxcloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+)
+
+func dataSourceObjectStorageTenants() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of Object Storage tenants.",
+		ReadContext: dataSourceObjectStorageTenantsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
+		Schema: mergeSchemas(schemaObjectStorageFilters("tenants", objectStorageTenantFilters...), map[string]*schema.Schema{
+			"tenants": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of tenants.",
+				Elem: &schema.Resource{
+					Schema: mergeSchemas(schemaObjectStorageTenantDataSource(), map[string]*schema.Schema{
+						"identifier": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the tenant.",
+						},
+					}),
+				},
+			},
+		}),
+	}
+}
+
+// objectStorageTenantFilters are the references tenants can be filtered by
+var objectStorageTenantFilters = []string{"customer", "backend"}
+
+// schemaObjectStorageTenantDataSource returns the computed attributes of tenants in data sources
+func schemaObjectStorageTenantDataSource() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the tenant.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Description of the tenant.",
+		},
+		"user_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the tenant user.",
+		},
+		"quota": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Maximum number of bytes allowed for objects within buckets.",
+		},
+		"usage": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Current number of bytes used within buckets.",
+		},
+		"backend": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Identifier of the S3 backend this tenant belongs to.",
+		},
+		"remote_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Tenant ID in the backend system.",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "State of the tenant.",
+		},
+		"reseller": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Reseller identifier.",
+		},
+		"customer": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Customer name, the API returns the name instead of the identifier.",
+		},
+	}
+}
+
+func dataSourceObjectStorageTenantsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	tenants, err := listObjectStorageTenants(ctx, a)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filtered := filterObjectStorage(tenants, expandObjectStorageFilter(d, objectStorageTenantFilters...))
+
+	tenantList := make([]interface{}, 0, len(filtered))
+	for i := range filtered {
+		tenantList = append(tenantList, flattenObjectStorageTenant(&filtered[i]))
+	}
+
+	if err := d.Set("tenants", tenantList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Set ID based on time to make resource unique
+	d.SetId(generateDataSourceID())
+
+	return nil
+}
+
+func listObjectStorageTenants(ctx context.Context, a api.API) ([]objectstoragev2.Tenant, error) {
+	var pageIter types.PageInfo
+	if err := a.List(ctx, &objectstoragev2.Tenant{}, api.Paged(1, 100, &pageIter)); err != nil {
+		return nil, err
+	}
+
+	tenants := make([]objectstoragev2.Tenant, 0, pageIter.TotalItems())
+	var pagedTenants []objectstoragev2.Tenant
+	for pageIter.Next(&pagedTenants) {
+		tenants = append(tenants, pagedTenants...)
+	}
+
+	if err := pageIter.Error(); err != nil {
+		return nil, err
+	}
+
+	return tenants, nil
+}
+
+func flattenObjectStorageTenant(tenant *objectstoragev2.Tenant) map[string]interface{} {
+	fields := map[string]interface{}{
+		"identifier":  tenant.Identifier,
+		"name":        tenant.Name,
+		"description": tenant.Description,
+		"user_name":   tenant.UserName,
+		"backend":     tenant.Backend.Identifier,
+		"reseller":    tenant.Reseller,
+		"customer":    tenant.Customer,
+	}
+
+	if tenant.Quota != nil {
+		fields["quota"] = *tenant.Quota
+	}
+	if tenant.Usage != nil {
+		fields["usage"] = *tenant.Usage
+	}
+	if tenant.RemoteID != nil {
+		fields["remote_id"] = *tenant.RemoteID
+	}
+
+	if tenant.State != nil {
+		fields["state"] = tenant.State.String()
+	}
+
+	return fields
+}
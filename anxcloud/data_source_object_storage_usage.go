@@ -0,0 +1,213 @@
+package anxcloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceObjectStorageUsage() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides the storage usage of Object Storage tenants and their buckets, compared to the quota of the tenants.",
+		ReadContext: dataSourceObjectStorageUsageRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
+		Schema: mergeSchemas(schemaObjectStorageReferenceFilters("tenants", objectStorageTenantFilters...), map[string]*schema.Schema{
+			"tenant_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only include the tenant with this identifier.",
+			},
+			"tenants": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Usage per tenant.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the tenant.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the tenant.",
+						},
+						"quota": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Maximum number of bytes allowed for objects within buckets.",
+						},
+						"usage": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Number of bytes used as reported for the tenant.",
+						},
+						"bucket_usage": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Collective size of objects in the buckets of the tenant.",
+						},
+						"object_count": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Number of objects in the buckets of the tenant.",
+						},
+						"bucket_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of buckets of the tenant.",
+						},
+						"quota_percentage": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Percentage of the quota used, based on the larger one of `usage` and `bucket_usage`.",
+						},
+						"buckets": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Usage per bucket of the tenant.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"identifier": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The identifier of the bucket.",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the bucket.",
+									},
+									"object_count": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "Number of objects in the bucket.",
+									},
+									"object_size": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "Collective size of objects in the bucket.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"total_quota": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Sum of the quotas of all included tenants.",
+			},
+			"total_usage": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Sum of the usage of all included tenants, based on the larger one of `usage` and `bucket_usage` of each tenant.",
+			},
+			"total_object_count": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Number of objects in the buckets of all included tenants.",
+			},
+		}),
+	}
+}
+
+func dataSourceObjectStorageUsageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	tenants, err := listObjectStorageTenants(ctx, a)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	buckets, err := listObjectStorageBuckets(ctx, a)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tenants = filterObjectStorage(tenants, expandObjectStorageReferenceFilter(d, objectStorageTenantFilters...))
+	bucketUsage := objectStorageBucketUsageByTenant(buckets)
+	tenantID := d.Get("tenant_filter").(string)
+
+	var totalQuota, totalUsage, totalObjectCount float64
+	tenantList := make([]interface{}, 0, len(tenants))
+
+	for i := range tenants {
+		tenant := &tenants[i]
+		if tenantID != "" && tenant.Identifier != tenantID {
+			continue
+		}
+
+		var quota, usage float64
+		if tenant.Quota != nil {
+			quota = *tenant.Quota
+		}
+		if tenant.Usage != nil {
+			usage = *tenant.Usage
+		}
+
+		bucketList := make([]interface{}, 0)
+		for j := range buckets {
+			if buckets[j].Tenant.Identifier != tenant.Identifier {
+				continue
+			}
+
+			bucketMap := map[string]interface{}{
+				"identifier": buckets[j].Identifier,
+				"name":       buckets[j].Name,
+			}
+			if objectCount, err := buckets[j].GetObjectCount(); err == nil {
+				bucketMap["object_count"] = float64(objectCount)
+			}
+			if objectSize, err := buckets[j].GetObjectSize(); err == nil {
+				bucketMap["object_size"] = float64(objectSize)
+			}
+
+			bucketList = append(bucketList, bucketMap)
+		}
+
+		tenantBucketUsage := bucketUsage[tenant.Identifier]
+		used := objectStorageQuotaUsage(usage, tenantBucketUsage.objectSize)
+
+		tenantList = append(tenantList, map[string]interface{}{
+			"identifier":       tenant.Identifier,
+			"name":             tenant.Name,
+			"quota":            quota,
+			"usage":            usage,
+			"bucket_usage":     tenantBucketUsage.objectSize,
+			"object_count":     tenantBucketUsage.objectCount,
+			"bucket_count":     len(bucketList),
+			"quota_percentage": objectStorageQuotaPercentage(quota, used),
+			"buckets":          bucketList,
+		})
+
+		totalQuota += quota
+		totalUsage += used
+		totalObjectCount += tenantBucketUsage.objectCount
+	}
+
+	var diags diag.Diagnostics
+	if err := d.Set("tenants", tenantList); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("total_quota", totalQuota); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("total_usage", totalUsage); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("total_object_count", totalObjectCount); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	// Set ID based on time to make resource unique
+	d.SetId(generateDataSourceID())
+
+	return diags
+}
@@ -0,0 +1,67 @@
+package anxcloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+)
+
+func dataSourceObjectStorageUser() *schema.Resource {
+	userSchema := mergeSchemas(
+		schemaObjectStorageUserDataSource(),
+		schemaObjectStorageReferenceFilters("users", objectStorageUserFilters...),
+	)
+
+	userSchema["identifier"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"identifier", "user_name"},
+		Description:  "The identifier of the user.",
+	}
+	userSchema["user_name"].Optional = true
+	userSchema["user_name"].Description += " Matched exactly, the filters select among users with the same name."
+
+	return &schema.Resource{
+		Description: "Retrieves an Object Storage user by identifier or name.",
+		ReadContext: dataSourceObjectStorageUserRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
+		Schema: userSchema,
+	}
+}
+
+func dataSourceObjectStorageUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	a := apiFromProviderConfig(m)
+
+	user := objectstoragev2.User{Identifier: d.Get("identifier").(string)}
+
+	if user.Identifier == "" {
+		users, err := listObjectStorageUsers(ctx, a)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		found, err := findObjectStorageByName(users, d.Get("user_name").(string), expandObjectStorageReferenceFilter(d, objectStorageUserFilters...))
+		if err != nil {
+			return diag.Errorf("failed retrieving user by name: %s", err)
+		}
+		user = *found
+	}
+
+	if err := a.Get(ctx, &user); err != nil {
+		return diag.Errorf("failed retrieving user: %s", err)
+	}
+
+	d.SetId(user.Identifier)
+
+	setObjectStorageDataSourceFields(d, flattenObjectStorageUser(&user), &diags)
+
+	return diags
+}
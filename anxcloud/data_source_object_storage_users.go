@@ -0,0 +1,162 @@
+package anxcloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+)
+
+func dataSourceObjectStorageUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of Object Storage users.",
+		ReadContext: dataSourceObjectStorageUsersRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
+		Schema: mergeSchemas(schemaObjectStorageFilters("users", objectStorageUserFilters...), map[string]*schema.Schema{
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of users.",
+				Elem: &schema.Resource{
+					Schema: mergeSchemas(schemaObjectStorageUserDataSource(), map[string]*schema.Schema{
+						"identifier": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the user.",
+						},
+					}),
+				},
+			},
+		}),
+	}
+}
+
+// objectStorageUserFilters are the references users can be filtered by
+var objectStorageUserFilters = []string{"customer", "backend", "tenant"}
+
+// schemaObjectStorageUserDataSource returns the computed attributes of users in data sources
+func schemaObjectStorageUserDataSource() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"user_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the user.",
+		},
+		"full_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The full name of the user.",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Indicates whether the user is enabled.",
+		},
+		"backend": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Identifier of the S3 backend this user belongs to.",
+		},
+		"tenant": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Identifier of the tenant this user belongs to.",
+		},
+		"remote_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Remote identifier of the user.",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "State of the user.",
+		},
+		"reseller": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Reseller identifier.",
+		},
+		"customer": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Customer name, the API returns the name instead of the identifier.",
+		},
+	}
+}
+
+func dataSourceObjectStorageUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	users, err := listObjectStorageUsers(ctx, a)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filtered := filterObjectStorage(users, expandObjectStorageFilter(d, objectStorageUserFilters...))
+
+	userList := make([]interface{}, 0, len(filtered))
+	for i := range filtered {
+		userList = append(userList, flattenObjectStorageUser(&filtered[i]))
+	}
+
+	if err := d.Set("users", userList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Set ID based on time to make resource unique
+	d.SetId(generateDataSourceID())
+
+	return nil
+}
+
+func listObjectStorageUsers(ctx context.Context, a api.API) ([]objectstoragev2.User, error) {
+	var pageIter types.PageInfo
+	if err := a.List(ctx, &objectstoragev2.User{}, api.Paged(1, 100, &pageIter)); err != nil {
+		return nil, err
+	}
+
+	users := make([]objectstoragev2.User, 0, pageIter.TotalItems())
+	var pagedUsers []objectstoragev2.User
+	for pageIter.Next(&pagedUsers) {
+		users = append(users, pagedUsers...)
+	}
+
+	if err := pageIter.Error(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func flattenObjectStorageUser(user *objectstoragev2.User) map[string]interface{} {
+	fields := map[string]interface{}{
+		"identifier": user.Identifier,
+		"user_name":  user.UserName,
+		"full_name":  user.FullName,
+		"backend":    user.Backend.Identifier,
+		"tenant":     user.Tenant.Identifier,
+		"reseller":   user.Reseller,
+		"customer":   user.Customer,
+	}
+
+	if user.Enabled != nil {
+		fields["enabled"] = *user.Enabled
+	}
+	if user.RemoteID != nil {
+		fields["remote_id"] = *user.RemoteID
+	}
+
+	if user.State != nil {
+		fields["state"] = user.State.String()
+	}
+
+	return fields
+}
@@ -0,0 +1,203 @@
+package anxcloud
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+
+	corev1internal "github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/apis/core/v1"
+)
+
+const (
+	taggedResourcesMatchAll = "all"
+	taggedResourcesMatchAny = "any"
+)
+
+func dataSourceTaggedResources() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides the resources carrying one or more tags, e.g. to build DNS records or load balancer pools from all resources tagged `role=ingress`.",
+		ReadContext: dataSourceTaggedResourcesRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Tags the resources have to carry.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"match": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      taggedResourcesMatchAll,
+				ValidateFunc: validation.StringInSlice([]string{taggedResourcesMatchAll, taggedResourcesMatchAny}, false),
+				Description:  "With `all`, resources have to carry all of the `tags`, with `any` at least one of them. Defaults to `all`.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only include resources of this type, matched against the identifier and the name of the type.",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Identifiers of the found resources.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of found resources, sorted by identifier.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource identifier.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource name.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the resource type.",
+						},
+						"type_identifier": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifier of the resource type.",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "All tags of the resource.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listCoreResourcesByTag(ctx context.Context, a api.API, tag string) ([]corev1internal.Resource, error) {
+	var pageIter types.PageInfo
+	if err := a.List(ctx, &corev1internal.Resource{Tag: tag}, api.Paged(1, 100, &pageIter)); err != nil {
+		return nil, err
+	}
+
+	resources := make([]corev1internal.Resource, 0, pageIter.TotalItems())
+	var pagedResources []corev1internal.Resource
+	for pageIter.Next(&pagedResources) {
+		resources = append(resources, pagedResources...)
+	}
+
+	if err := pageIter.Error(); err != nil {
+		return nil, err
+	}
+
+	return resources, nil
+}
+
+// combineTaggedResources returns the resources listed for all tags if matchAll is set, otherwise the
+// resources listed for any tag, sorted by identifier
+func combineTaggedResources(resourcesByTag [][]corev1internal.Resource, matchAll bool) []corev1internal.Resource {
+	resources := make(map[string]corev1internal.Resource)
+	matches := make(map[string]int)
+
+	for _, tagResources := range resourcesByTag {
+		seen := make(map[string]bool, len(tagResources))
+		for _, resource := range tagResources {
+			if seen[resource.Identifier] {
+				continue
+			}
+			seen[resource.Identifier] = true
+
+			resources[resource.Identifier] = resource
+			matches[resource.Identifier]++
+		}
+	}
+
+	combined := make([]corev1internal.Resource, 0, len(resources))
+	for identifier, resource := range resources {
+		if matchAll && matches[identifier] != len(resourcesByTag) {
+			continue
+		}
+		combined = append(combined, resource)
+	}
+
+	sort.Slice(combined, func(i, j int) bool {
+		return combined[i].Identifier < combined[j].Identifier
+	})
+
+	return combined
+}
+
+func dataSourceTaggedResourcesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	tags := mustCastInterfaceArray[string](d.Get("tags").(*schema.Set).List())
+	sort.Strings(tags)
+
+	resourcesByTag := make([][]corev1internal.Resource, 0, len(tags))
+	for _, tag := range tags {
+		resources, err := listCoreResourcesByTag(ctx, a, tag)
+		if err != nil {
+			return diag.Errorf("failed listing resources tagged %q: %s", tag, err)
+		}
+		resourcesByTag = append(resourcesByTag, resources)
+	}
+
+	resourceType := d.Get("resource_type").(string)
+	ids := make([]interface{}, 0)
+	resourceList := make([]interface{}, 0)
+
+	for _, resource := range combineTaggedResources(resourcesByTag, d.Get("match").(string) == taggedResourcesMatchAll) {
+		// listed resources don't include their type and tags
+		if err := a.Get(ctx, &resource); api.IgnoreNotFound(err) != nil {
+			return diag.Errorf("failed retrieving resource %q: %s", resource.Identifier, err)
+		} else if err != nil {
+			// deleted since it was listed
+			continue
+		}
+
+		if resourceType != "" && resource.Type.Identifier != resourceType && resource.Type.Name != resourceType {
+			continue
+		}
+
+		ids = append(ids, resource.Identifier)
+		resourceList = append(resourceList, map[string]interface{}{
+			"identifier":      resource.Identifier,
+			"name":            resource.Name,
+			"type":            resource.Type.Name,
+			"type_identifier": resource.Type.Identifier,
+			"tags":            resource.Tags,
+		})
+	}
+
+	var diags diag.Diagnostics
+	if err := d.Set("ids", ids); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("resources", resourceList); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	d.SetId(generateDataSourceID())
+
+	return diags
+}
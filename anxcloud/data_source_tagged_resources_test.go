@@ -0,0 +1,39 @@
+package anxcloud
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	corev1internal "github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/apis/core/v1"
+)
+
+func TestCombineTaggedResources(t *testing.T) {
+	a := corev1internal.Resource{Identifier: "a"}
+	b := corev1internal.Resource{Identifier: "b"}
+	c := corev1internal.Resource{Identifier: "c"}
+
+	resourcesByTag := [][]corev1internal.Resource{
+		{c, a, b},
+		{b, c, c},
+	}
+
+	type testCase struct {
+		actual   []corev1internal.Resource
+		expected []corev1internal.Resource
+	}
+
+	testCases := []testCase{
+		{combineTaggedResources(resourcesByTag, true), []corev1internal.Resource{b, c}},
+		{combineTaggedResources(resourcesByTag, false), []corev1internal.Resource{a, b, c}},
+		{combineTaggedResources(resourcesByTag[:1], true), []corev1internal.Resource{a, b, c}},
+		{combineTaggedResources(append(resourcesByTag, nil), true), []corev1internal.Resource{}},
+		{combineTaggedResources(append(resourcesByTag, nil), false), []corev1internal.Resource{a, b, c}},
+	}
+
+	for _, testCase := range testCases {
+		if diff := cmp.Diff(testCase.actual, testCase.expected); diff != "" {
+			t.Errorf("(-expected +actual):\n%s", diff)
+		}
+	}
+}
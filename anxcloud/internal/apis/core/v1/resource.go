@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+// Resource is a resource of the core API, listed resources are filtered by Tag if it is set
+type Resource struct {
+	Identifier string       `json:"identifier"`
+	Name       string       `json:"name"`
+	Type       ResourceType `json:"resource_type"`
+	Tags       []string     `json:"tags"`
+
+	Tag string `json:"-"`
+}
+
+// ResourceType is the type of a core resource
+type ResourceType struct {
+	Identifier string `json:"identifier"`
+	Name       string `json:"name"`
+}
+
+func (r *Resource) GetIdentifier(ctx context.Context) (string, error) {
+	return r.Identifier, nil
+}
+
+func (r *Resource) EndpointURL(ctx context.Context) (*url.URL, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != types.OperationList && op != types.OperationGet {
+		return nil, errors.New("helper resource 'Resource' only supports list and get operations")
+	}
+
+	u, err := url.Parse("/api/core/v1/resource.json")
+	if err != nil {
+		return nil, err
+	}
+
+	if op == types.OperationList && r.Tag != "" {
+		query := u.Query()
+		query.Set("tag_name", r.Tag)
+		u.RawQuery = query.Encode()
+	}
+
+	return u, nil
+}
@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"go.anx.io/go-anxcloud/pkg/api/types"
+)
+
+// Tag is a tag of the core API. A tag is created by assigning it to the service of an organisation,
+// assigning an existing name to another service or organisation adds an assignment to the same tag.
+type Tag struct {
+	Identifier string `json:"identifier,omitempty"`
+	Name       string `json:"name"`
+
+	// only used to create an assignment
+	ServiceIdentifier  string `json:"service_identifier,omitempty"`
+	CustomerIdentifier string `json:"customer_identifier,omitempty"`
+
+	Organisations []TagOrganisation `json:"organisation_assignments,omitempty"`
+}
+
+// TagOrganisation is the assignment of a tag to the service of an organisation
+type TagOrganisation struct {
+	Customer TagCustomer `json:"customer"`
+	Service  TagService  `json:"service"`
+}
+
+// TagCustomer is the organisation a tag is assigned to
+type TagCustomer struct {
+	Identifier string `json:"identifier"`
+	CustomerID string `json:"customer_id"`
+	Demo       bool   `json:"demo"`
+	Name       string `json:"name"`
+	Slug       string `json:"name_slug"`
+	Reseller   string `json:"reseller"`
+}
+
+// TagService is the service a tag is assigned to
+type TagService struct {
+	Identifier string `json:"identifier"`
+	Name       string `json:"name"`
+}
+
+func (t *Tag) GetIdentifier(ctx context.Context) (string, error) {
+	return t.Identifier, nil
+}
+
+func (t *Tag) EndpointURL(ctx context.Context) (*url.URL, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != types.OperationCreate && op != types.OperationGet {
+		return nil, errors.New("helper resource 'Tag' only supports create and get operations, use 'TagAssignment' to remove assignments")
+	}
+
+	return url.Parse("/api/core/v1/tag.json")
+}
+
+// TagAssignment removes the assignment of a tag to a service, the tag is deleted with its last assignment
+type TagAssignment struct {
+	TagIdentifier     string `json:"-"`
+	ServiceIdentifier string `json:"-"`
+}
+
+func (a *TagAssignment) GetIdentifier(ctx context.Context) (string, error) {
+	return a.ServiceIdentifier, nil
+}
+
+func (a *TagAssignment) EndpointURL(ctx context.Context) (*url.URL, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != types.OperationDestroy {
+		return nil, errors.New("helper resource 'TagAssignment' only supports destroy operations")
+	}
+
+	return url.Parse(fmt.Sprintf("/api/core/v1/tag.json/%s", a.TagIdentifier))
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
@@ -29,3 +30,108 @@ func (d *E5EFunctionDeployment) EndpointURL(ctx context.Context) (*url.URL, erro
 
 	return url.Parse(fmt.Sprintf("/api/e5e/v1/function.json/%s/deploy", d.FunctionIdentifier))
 }
+
+// E5EFunctionDeploymentInfo is a past or ongoing deployment of a function
+type E5EFunctionDeploymentInfo struct {
+	FunctionIdentifier string `json:"-"`
+	Identifier         string `json:"identifier"`
+	State              string `json:"state"`
+	Error              string `json:"error"`
+	Log                string `json:"log"`
+	CreatedAt          string `json:"created_at"`
+	FinishedAt         string `json:"finished_at"`
+}
+
+func (d *E5EFunctionDeploymentInfo) GetIdentifier(ctx context.Context) (string, error) {
+	return d.Identifier, nil
+}
+
+func (d *E5EFunctionDeploymentInfo) EndpointURL(ctx context.Context) (*url.URL, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != types.OperationList && op != types.OperationGet {
+		return nil, errors.New("helper resource 'E5EFunctionDeploymentInfo' only supports list and get operations")
+	}
+
+	return url.Parse(fmt.Sprintf("/api/e5e/v1/function.json/%s/deployments", d.FunctionIdentifier))
+}
+
+// E5EFunctionInvocation synchronously invokes a deployed function with the given payload
+type E5EFunctionInvocation struct {
+	FunctionIdentifier string          `json:"-"`
+	Payload            json.RawMessage `json:"data"`
+
+	Status          int               `json:"status,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	ResponseBody    string            `json:"response_body,omitempty"`
+}
+
+func (i *E5EFunctionInvocation) GetIdentifier(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (i *E5EFunctionInvocation) EndpointURL(ctx context.Context) (*url.URL, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != types.OperationCreate {
+		return nil, errors.New("helper resource 'E5EFunctionInvocation' only supports create operations")
+	}
+
+	return url.Parse(fmt.Sprintf("/api/e5e/v1/function.json/%s/invoke", i.FunctionIdentifier))
+}
+
+// E5ERuntime is a runtime functions can be executed with
+type E5ERuntime struct {
+	Identifier string `json:"identifier"`
+	Name       string `json:"name"`
+}
+
+func (r *E5ERuntime) GetIdentifier(ctx context.Context) (string, error) {
+	return r.Identifier, nil
+}
+
+func (r *E5ERuntime) EndpointURL(ctx context.Context) (*url.URL, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != types.OperationList {
+		return nil, errors.New("helper resource 'E5ERuntime' only supports list operations")
+	}
+
+	return url.Parse("/api/e5e/v1/runtime.json")
+}
+
+// E5EWorkerType is a worker type functions can be executed on, including the maximum
+// quotas functions of this worker type can be granted. A maximum of zero means unlimited.
+type E5EWorkerType struct {
+	Identifier      string `json:"identifier"`
+	Name            string `json:"name"`
+	MaxQuotaMemory  int    `json:"max_quota_memory"`
+	MaxQuotaCPU     int    `json:"max_quota_cpu"`
+	MaxQuotaTimeout int    `json:"max_quota_timeout"`
+}
+
+func (w *E5EWorkerType) GetIdentifier(ctx context.Context) (string, error) {
+	return w.Identifier, nil
+}
+
+func (w *E5EWorkerType) EndpointURL(ctx context.Context) (*url.URL, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != types.OperationList {
+		return nil, errors.New("helper resource 'E5EWorkerType' only supports list operations")
+	}
+
+	return url.Parse("/api/e5e/v1/worker_type.json")
+}
@@ -12,43 +12,153 @@ type BatchUnitResult[T any] struct {
 }
 
 type batchUnitRequest[T, U any] struct {
-	in T
-	ch chan BatchUnitResult[U]
+	ctx context.Context
+	in  T
+	ch  chan BatchUnitResult[U]
 }
 
+// Batcher collects units passed to Process and hands them to BatchFunc in a single call.
+// A batch is flushed once Wait has passed since its first unit arrived or as soon as
+// it contains MaxSize units. A MaxSize of zero or less disables the size limit.
+// Discard, if set, is called with the successful results of units whose caller returned
+// before the result was available, e.g. to release resources allocated for them.
 type Batcher[T, U any] struct {
 	sync.Mutex
-	batch     []batchUnitRequest[T, U]
-	startWait sync.Once
+	batch     []*batchUnitRequest[T, U]
+	timer     *time.Timer
+	timerGen  uint64
+	running   sync.Mutex
 	Wait      time.Duration
+	MaxSize   int
 	BatchFunc func(context.Context, []T) []BatchUnitResult[U]
+	Discard   func(U)
 }
 
+// Process adds the unit to the current batch and blocks until the batch was processed
+// or the passed context is done. Units whose context is done before their batch was
+// flushed are removed from the batch again, results of units whose batch was flushed
+// already are passed to Discard.
 func (b *Batcher[T, U]) Process(ctx context.Context, in T) (U, error) {
+	req := &batchUnitRequest[T, U]{ctx, in, make(chan BatchUnitResult[U], 1)}
+
+	b.Lock()
+	b.batch = append(b.batch, req)
+	if b.MaxSize > 0 && len(b.batch) >= b.MaxSize {
+		go b.processBatch(b.takeBatch())
+	} else if b.timer == nil {
+		gen := b.timerGen
+		b.timer = time.AfterFunc(b.Wait, func() { b.flush(gen) })
+	}
+	b.Unlock()
+
+	select {
+	case res := <-req.ch:
+		return res.Data, res.Error
+	case <-ctx.Done():
+		if !b.remove(req) && b.Discard != nil {
+			go func() {
+				if res := <-req.ch; res.Error == nil {
+					b.Discard(res.Data)
+				}
+			}()
+		}
+		var zero U
+		return zero, ctx.Err()
+	}
+}
+
+// flush processes the current batch, unless the timer of generation gen was superseded
+// in the meantime because the batch was flushed already.
+func (b *Batcher[T, U]) flush(gen uint64) {
 	b.Lock()
-	ch := make(chan BatchUnitResult[U], 1)
-	b.batch = append(b.batch, batchUnitRequest[T, U]{in, ch})
-	b.startWait.Do(func() { time.AfterFunc(b.Wait, func() { b.processBatch(ctx) }) })
+	if gen != b.timerGen {
+		b.Unlock()
+		return
+	}
+	batch := b.takeBatch()
 	b.Unlock()
 
-	res := <-ch
-	return res.Data, res.Error
+	if len(batch) > 0 {
+		b.processBatch(batch)
+	}
+}
+
+// takeBatch detaches the current batch from the batcher. The caller must hold the lock.
+func (b *Batcher[T, U]) takeBatch() []*batchUnitRequest[T, U] {
+	b.stopTimer()
+
+	batch := b.batch
+	b.batch = nil
+	return batch
 }
 
-func (b *Batcher[T, U]) processBatch(ctx context.Context) {
+// remove removes the unit from the current batch, it returns false if the unit isn't part
+// of the current batch anymore because its batch was flushed already.
+func (b *Batcher[T, U]) remove(req *batchUnitRequest[T, U]) bool {
 	b.Lock()
 	defer b.Unlock()
 
-	in := make([]T, len(b.batch))
+	removed := false
 	for i, elem := range b.batch {
+		if elem == req {
+			b.batch = append(b.batch[:i], b.batch[i+1:]...)
+			removed = true
+			break
+		}
+	}
+
+	if len(b.batch) == 0 {
+		b.stopTimer()
+	}
+
+	return removed
+}
+
+// stopTimer stops the pending flush timer. The caller must hold the lock.
+func (b *Batcher[T, U]) stopTimer() {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+		b.timerGen++
+	}
+}
+
+func (b *Batcher[T, U]) processBatch(batch []*batchUnitRequest[T, U]) {
+	// batches of the same batcher are processed one after another
+	b.running.Lock()
+	defer b.running.Unlock()
+
+	ctx, cancel := batchContext(batch)
+	defer cancel()
+
+	in := make([]T, len(batch))
+	for i, elem := range batch {
 		in[i] = elem.in
 	}
 
 	out := b.BatchFunc(ctx, in)
-	for i, elem := range b.batch {
+	for i, elem := range batch {
 		elem.ch <- out[i]
 	}
+}
 
-	b.batch = nil
-	b.startWait = sync.Once{}
+// batchContext returns a context which isn't canceled together with the contexts of the
+// callers, but is bounded by the latest deadline of them. The values of the first
+// caller's context are retained.
+func batchContext[T, U any](batch []*batchUnitRequest[T, U]) (context.Context, context.CancelFunc) {
+	ctx := context.WithoutCancel(batch[0].ctx)
+
+	var deadline time.Time
+	for _, elem := range batch {
+		d, ok := elem.ctx.Deadline()
+		if !ok {
+			// at least one caller is willing to wait forever
+			return context.WithCancel(ctx)
+		}
+		if d.After(deadline) {
+			deadline = d
+		}
+	}
+
+	return context.WithDeadline(ctx, deadline)
 }
@@ -0,0 +1,183 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func identityBatchFunc(calls chan<- []int) func(context.Context, []int) []BatchUnitResult[int] {
+	return func(ctx context.Context, in []int) []BatchUnitResult[int] {
+		calls <- in
+		out := make([]BatchUnitResult[int], len(in))
+		for i, v := range in {
+			out[i].Data = v
+		}
+		return out
+	}
+}
+
+func TestBatcherFlushesAfterWait(t *testing.T) {
+	calls := make(chan []int, 10)
+	b := &Batcher[int, int]{
+		Wait:      50 * time.Millisecond,
+		BatchFunc: identityBatchFunc(calls),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			out, err := b.Process(context.Background(), i)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			} else if out != i {
+				t.Errorf("expected %d, got %d", i, out)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if batch := <-calls; len(batch) != 3 {
+		t.Errorf("expected a single batch with 3 units, got %v", batch)
+	}
+	if len(calls) != 0 {
+		t.Errorf("expected a single batch, got %d more", len(calls))
+	}
+}
+
+func TestBatcherFlushesWhenFull(t *testing.T) {
+	calls := make(chan []int, 10)
+	b := &Batcher[int, int]{
+		Wait:      time.Hour,
+		MaxSize:   2,
+		BatchFunc: identityBatchFunc(calls),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, err := b.Process(context.Background(), i); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	close(calls)
+	for batch := range calls {
+		if len(batch) != 2 {
+			t.Errorf("expected batches with 2 units, got %v", batch)
+		}
+	}
+}
+
+func TestBatcherRespectsCallerContext(t *testing.T) {
+	calls := make(chan []int, 10)
+	b := &Batcher[int, int]{
+		Wait:      100 * time.Millisecond,
+		BatchFunc: identityBatchFunc(calls),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, err := b.Process(ctx, 1); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Process didn't return after its context was canceled")
+	}
+
+	if out, err := b.Process(context.Background(), 2); err != nil || out != 2 {
+		t.Errorf("expected 2 without error, got %d and %v", out, err)
+	}
+
+	if batch := <-calls; len(batch) != 1 || batch[0] != 2 {
+		t.Errorf("expected canceled unit to be removed from batch, got %v", batch)
+	}
+}
+
+func TestBatcherContextOutlivesCallers(t *testing.T) {
+	deadlines := make(chan time.Time, 1)
+	b := &Batcher[int, int]{
+		Wait: 10 * time.Millisecond,
+		BatchFunc: func(ctx context.Context, in []int) []BatchUnitResult[int] {
+			deadline, _ := ctx.Deadline()
+			deadlines <- deadline
+			return make([]BatchUnitResult[int], len(in))
+		},
+	}
+
+	short, cancelShort := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelShort()
+	long, cancelLong := context.WithTimeout(context.Background(), time.Hour)
+	defer cancelLong()
+
+	var wg sync.WaitGroup
+	for _, ctx := range []context.Context{short, long} {
+		wg.Add(1)
+		go func(ctx context.Context) {
+			defer wg.Done()
+			_, _ = b.Process(ctx, 0)
+		}(ctx)
+	}
+	wg.Wait()
+
+	expected, _ := long.Deadline()
+	if deadline := <-deadlines; !deadline.Equal(expected) {
+		t.Errorf("expected batch deadline %s, got %s", expected, deadline)
+	}
+}
+
+func TestBatcherDiscardsResultsOfCanceledCallers(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	discarded := make(chan int, 1)
+
+	b := &Batcher[int, int]{
+		Wait: 10 * time.Millisecond,
+		BatchFunc: func(ctx context.Context, in []int) []BatchUnitResult[int] {
+			close(started)
+			<-release
+			return []BatchUnitResult[int]{{Data: in[0]}}
+		},
+		Discard: func(out int) { discarded <- out },
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, err := b.Process(ctx, 1); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	}()
+
+	<-started
+	cancel()
+	<-done
+	close(release)
+
+	select {
+	case out := <-discarded:
+		if out != 1 {
+			t.Errorf("expected result 1 to be discarded, got %d", out)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("result of canceled caller wasn't discarded")
+	}
+}
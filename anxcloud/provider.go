@@ -8,10 +8,13 @@ import (
 	"net/http"
 	"os"
 	"runtime"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"go.anx.io/go-anxcloud/pkg/api"
 	"go.anx.io/go-anxcloud/pkg/client"
 )
@@ -29,6 +32,18 @@ func Provider(version string) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ANEXIA_TOKEN", nil),
 				Description: "Anexia Cloud token.",
 			},
+			"dns_batch_wait_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  dnsBatchWaitSecondsDescription,
+			},
+			"dns_batch_max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  dnsBatchMaxSizeDescription,
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"anxcloud_virtual_server":        resourceVirtualServer(),
@@ -36,6 +51,7 @@ func Provider(version string) *schema.Provider {
 			"anxcloud_network_prefix":        resourceNetworkPrefix(),
 			"anxcloud_ip_address":            resourceIPAddress(),
 			"anxcloud_tag":                   resourceTag(),
+			"anxcloud_resource_tags":         resourceResourceTags(),
 			"anxcloud_dns_zone":              resourceDNSZone(),
 			"anxcloud_dns_record":            resourceDNSRecord(),
 			"anxcloud_lbaas_loadbalancer":    resourceLBaaSLoadBalancer(),
@@ -48,6 +64,7 @@ func Provider(version string) *schema.Provider {
 			"anxcloud_frontier_endpoint":     resourceFrontierEndpoint(),
 			"anxcloud_frontier_action":       resourceFrontierAction(),
 			"anxcloud_frontier_deployment":   resourceFrontierDeployment(),
+			"anxcloud_frontier_openapi":      resourceFrontierOpenAPI(),
 			// Object Storage resources
 			"anxcloud_object_storage_endpoint": resourceObjectStorageEndpoint(),
 			"anxcloud_object_storage_backend":  resourceObjectStorageBackend(),
@@ -56,12 +73,16 @@ func Provider(version string) *schema.Provider {
 			"anxcloud_object_storage_user":     resourceObjectStorageUser(),
 			"anxcloud_object_storage_key":      resourceObjectStorageKey(),
 			"anxcloud_object_storage_region":   resourceObjectStorageRegion(),
+			"anxcloud_object_storage_object":   resourceObjectStorageObject(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"anxcloud_disk_types":            dataSourceDiskTypes(),
 			"anxcloud_template":              dataSourceTemplate(),
 			"anxcloud_ip_address":            dataSourceIPAddress(),
 			"anxcloud_ip_addresses":          dataSourceIPAddresses(),
+			"anxcloud_free_ip_addresses":     dataSourceFreeIPAddresses(),
+			"anxcloud_network_prefix":        dataSourceNetworkPrefix(),
+			"anxcloud_network_prefixes":      dataSourceNetworkPrefixes(),
 			"anxcloud_nic_types":             dataSourceNICTypes(),
 			"anxcloud_core_location":         dataSourceCoreLocation(),
 			"anxcloud_core_locations":        dataSourceCoreLocations(),
@@ -69,22 +90,63 @@ func Provider(version string) *schema.Provider {
 			"anxcloud_vlan":                  dataSourceVLAN(),
 			"anxcloud_vlans":                 dataSourceVLANs(),
 			"anxcloud_tags":                  dataSourceTags(),
+			"anxcloud_tagged_resources":      dataSourceTaggedResources(),
 			"anxcloud_cpu_performance_types": dataSourceCPUPerformanceTypes(),
 			"anxcloud_dns_records":           dataSourceDNSRecords(),
 			"anxcloud_dns_zones":             datasourceDNSZones(),
 			"anxcloud_kubernetes_cluster":    dataSourceKubernetesCluster(),
+			// e5e data sources
+			"anxcloud_e5e_application":          dataSourceE5EApplication(),
+			"anxcloud_e5e_applications":         dataSourceE5EApplications(),
+			"anxcloud_e5e_function":             dataSourceE5EFunction(),
+			"anxcloud_e5e_functions":            dataSourceE5EFunctions(),
+			"anxcloud_e5e_function_deployments": dataSourceE5EFunctionDeployments(),
+			"anxcloud_e5e_function_invocation":  dataSourceE5EFunctionInvocation(),
+			"anxcloud_e5e_runtimes":             dataSourceE5ERuntimes(),
+			"anxcloud_e5e_worker_types":         dataSourceE5EWorkerTypes(),
+			// Frontier data sources
+			"anxcloud_frontier_api":         dataSourceFrontierAPI(),
+			"anxcloud_frontier_apis":        dataSourceFrontierAPIs(),
+			"anxcloud_frontier_endpoint":    dataSourceFrontierEndpoint(),
+			"anxcloud_frontier_endpoints":   dataSourceFrontierEndpoints(),
+			"anxcloud_frontier_action":      dataSourceFrontierAction(),
+			"anxcloud_frontier_actions":     dataSourceFrontierActions(),
+			"anxcloud_frontier_deployment":  dataSourceFrontierDeployment(),
+			"anxcloud_frontier_deployments": dataSourceFrontierDeployments(),
 			// Object Storage data sources
 			"anxcloud_object_storage_endpoints": dataSourceObjectStorageEndpoints(),
 			"anxcloud_object_storage_backends":  dataSourceObjectStorageBackends(),
 			"anxcloud_object_storage_regions":   dataSourceObjectStorageRegions(),
+			"anxcloud_object_storage_tenant":    dataSourceObjectStorageTenant(),
+			"anxcloud_object_storage_tenants":   dataSourceObjectStorageTenants(),
+			"anxcloud_object_storage_user":      dataSourceObjectStorageUser(),
+			"anxcloud_object_storage_users":     dataSourceObjectStorageUsers(),
+			"anxcloud_object_storage_bucket":    dataSourceObjectStorageBucket(),
+			"anxcloud_object_storage_buckets":   dataSourceObjectStorageBuckets(),
+			"anxcloud_object_storage_key":       dataSourceObjectStorageKey(),
+			"anxcloud_object_storage_keys":      dataSourceObjectStorageKeys(),
+			"anxcloud_object_storage_usage":     dataSourceObjectStorageUsage(),
 		},
 		ConfigureContextFunc: providerConfigure(version),
 	}
 }
 
+const (
+	dnsBatchWaitSecondsDescription = "Number of seconds DNS record create and delete operations of the same zone are collected before they are sent to the API as a single change set. Defaults to 15 seconds."
+	dnsBatchMaxSizeDescription     = "Maximum number of DNS record operations sent as a single change set. A batch is sent early once it reached this size. Defaults to 0, which means unlimited."
+
+	defaultDNSBatchWait = 15 * time.Second
+)
+
 type providerContext struct {
 	api          api.API
 	legacyClient client.Client
+
+	dnsBatchWait      time.Duration
+	dnsBatchMaxSize   int
+	dnsRecordBatchers *sync.Map
+	addressReservers  *sync.Map
+	e5eCatalog        *e5eCatalog
 }
 
 func providerConfigure(version string) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -125,9 +187,19 @@ func providerConfigure(version string) func(context.Context, *schema.ResourceDat
 			return nil, diags
 		}
 
+		dnsBatchWait := defaultDNSBatchWait
+		if seconds, ok := d.GetOk("dns_batch_wait_seconds"); ok {
+			dnsBatchWait = time.Duration(seconds.(int)) * time.Second
+		}
+
 		return providerContext{
-			api:          apiClient,
-			legacyClient: c,
+			api:               apiClient,
+			legacyClient:      c,
+			dnsBatchWait:      dnsBatchWait,
+			dnsBatchMaxSize:   d.Get("dns_batch_max_size").(int),
+			dnsRecordBatchers: &sync.Map{},
+			addressReservers:  &sync.Map{},
+			e5eCatalog:        &e5eCatalog{},
 		}, diags
 	}
 }
@@ -10,7 +10,6 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/utils"
@@ -46,13 +45,12 @@ func resourceDNSRecord() *schema.Resource {
 	}
 }
 
-var resourceDNSRecordBatcherMap sync.Map
-
-func resourceDNSRecordBatcherForZone(a api.API, zoneName string) *utils.Batcher[recordBatchUnit, any] {
-	anyBatcher, _ := resourceDNSRecordBatcherMap.LoadOrStore(zoneName, &utils.Batcher[recordBatchUnit, any]{
-		// this will consume 15 seconds of the 2 minute create/delete budget
-		Wait:      15 * time.Second,
-		BatchFunc: resourceDNSRecordBatch(a, zoneName),
+func resourceDNSRecordBatcherForZone(pc providerContext, zoneName string) *utils.Batcher[recordBatchUnit, any] {
+	anyBatcher, _ := pc.dnsRecordBatchers.LoadOrStore(zoneName, &utils.Batcher[recordBatchUnit, any]{
+		// this will consume the wait duration (15 seconds by default) of the 2 minute create/delete budget
+		Wait:      pc.dnsBatchWait,
+		MaxSize:   pc.dnsBatchMaxSize,
+		BatchFunc: resourceDNSRecordBatch(pc.api, zoneName),
 	})
 
 	return anyBatcher.(*utils.Batcher[recordBatchUnit, any])
@@ -60,7 +58,7 @@ func resourceDNSRecordBatcherForZone(a api.API, zoneName string) *utils.Batcher[
 
 func resourceDNSRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	a := apiFromProviderConfig(m)
-	batcher := resourceDNSRecordBatcherForZone(a, d.Get("zone_name").(string))
+	batcher := resourceDNSRecordBatcherForZone(m.(providerContext), d.Get("zone_name").(string))
 
 	r := dnsRecordFromResourceData(d)
 
@@ -156,7 +154,7 @@ func resourceDNSRecordUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 func resourceDNSRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	a := apiFromProviderConfig(m)
-	batcher := resourceDNSRecordBatcherForZone(a, d.Get("zone_name").(string))
+	batcher := resourceDNSRecordBatcherForZone(m.(providerContext), d.Get("zone_name").(string))
 
 	r := dnsRecordFromResourceData(d)
 	r, err := findDNSRecord(ctx, a, r)
@@ -233,7 +231,7 @@ func resourceDNSRecordBatch(a api.API, zoneName string) func(ctx context.Context
 	return func(ctx context.Context, records []recordBatchUnit) []utils.BatchUnitResult[any] {
 		res := make([]utils.BatchUnitResult[any], len(records))
 
-		// ridiculously high timeout -> will be canceld before by schema timeout
+		// ridiculously high timeout -> will be canceled before by the latest schema timeout of the batched operations
 		err := retry.RetryContext(ctx, time.Hour, func() *retry.RetryError {
 			zone := clouddnsv1.Zone{Name: zoneName}
 			if err := a.Get(ctx, &zone); err != nil {
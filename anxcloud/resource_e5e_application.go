@@ -14,14 +14,14 @@ func resourceE5EApplication() *schema.Resource {
 	return &schema.Resource{
 		Description: "Applications are an easy way to bring more structure to your configured functions by grouping them." +
 			" You can imagine an application as a folder to put in your functions.",
-		CreateContext: resourceE5EApplicationCreate,
-		ReadContext:   resourceE5EApplicationRead,
-		UpdateContext: resourceE5EApplicationUpdate,
+		CreateContext: tagsMiddlewareCreate(resourceE5EApplicationCreate),
+		ReadContext:   tagsMiddlewareRead(resourceE5EApplicationRead),
+		UpdateContext: tagsMiddlewareUpdate(resourceE5EApplicationUpdate),
 		DeleteContext: resourceE5EApplicationDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: map[string]*schema.Schema{
+		Schema: withTagsAttribute(map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -32,7 +32,7 @@ func resourceE5EApplication() *schema.Resource {
 				Required:    true,
 				Description: "Application name.",
 			},
-		},
+		}),
 	}
 }
 
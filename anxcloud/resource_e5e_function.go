@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	e5ev1internal "github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/apis/e5e/v1"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"go.anx.io/go-anxcloud/pkg/api"
@@ -18,14 +22,24 @@ func resourceE5EFunction() *schema.Resource {
 	var availableStorageBackends = []string{"storage_backend_git", "storage_backend_archive", "storage_backend_s3"}
 	return &schema.Resource{
 		Description:   "A function is the collection of all the metadata as well as the code itself that is needed to execute your application on the e5e platform.",
-		CreateContext: resourceE5EFunctionCreate,
-		ReadContext:   resourceE5EFunctionRead,
-		UpdateContext: resourceE5EFunctionUpdate,
+		CreateContext: tagsMiddlewareCreate(resourceE5EFunctionCreate),
+		ReadContext:   tagsMiddlewareRead(resourceE5EFunctionRead),
+		UpdateContext: tagsMiddlewareUpdate(resourceE5EFunctionUpdate),
 		DeleteContext: resourceE5EFunctionDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: map[string]*schema.Schema{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		CustomizeDiff: customdiff.All(
+			resourceE5EFunctionCustomizeSourceHash,
+			resourceE5EFunctionCustomizeDeployment,
+			resourceE5EFunctionCustomizeCatalog,
+			resourceE5EFunctionCustomizeEnvironment,
+		),
+		Schema: withTagsAttribute(map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -44,7 +58,7 @@ func resourceE5EFunction() *schema.Resource {
 			"runtime": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Function runtime.",
+				Description: "Function runtime. Available runtimes are provided by the `anxcloud_e5e_runtimes` data source.",
 			},
 			"entrypoint": {
 				Type:        schema.TypeString,
@@ -92,8 +106,37 @@ func resourceE5EFunction() *schema.Resource {
 				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"content": {Type: schema.TypeString, Required: true},
-						"name":    {Type: schema.TypeString, Required: true},
+						"content": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Base64 encoded archive as data URI.",
+							ExactlyOneOf: []string{"storage_backend_archive.0.content", "storage_backend_archive.0.source_dir"},
+							RequiredWith: []string{"storage_backend_archive.0.name"},
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Archive name. Defaults to the name of `source_dir` with a `.zip` suffix when `source_dir` is used.",
+						},
+						"source_dir": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Local directory which is packaged into a zip archive by the provider. The function is redeployed whenever the packaged files change.",
+							ExactlyOneOf: []string{"storage_backend_archive.0.content", "storage_backend_archive.0.source_dir"},
+						},
+						"include": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: "Glob patterns of files in `source_dir` to package, defaults to all files." +
+								" Patterns are matched against the slash separated path relative to `source_dir`, `**` matches any number of directories.",
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
+						"exclude": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Glob patterns of files and directories in `source_dir` to leave out of the package. Takes precedence over `include`.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 				ConflictsWith: []string{"storage_backend_s3", "storage_backend_git"},
@@ -102,7 +145,9 @@ func resourceE5EFunction() *schema.Resource {
 			"env": {
 				Type: schema.TypeList,
 				Description: "Environment variables available to the function." +
-					" Note: the provider is not aware of external changes to secret environment variables.",
+					" Note: the API returns secret environment variables without their value or a fingerprint of it," +
+					" so the provider can't detect external changes to them." +
+					" Use `value_wo` and increment `value_wo_version` to apply a secret value again.",
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -111,8 +156,20 @@ func resourceE5EFunction() *schema.Resource {
 							Required: true,
 						},
 						"value": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Value of the environment variable, either `value` or `value_wo` is required.",
+						},
+						"value_wo": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							WriteOnly:   true,
+							Description: "Write-only value of a secret environment variable, it is not stored in the state. Requires Terraform 1.11 or later.",
+						},
+						"value_wo_version": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Version of `value_wo`, increment it to apply the value again, e.g. after the secret was changed outside of Terraform.",
 						},
 						"secret": {
 							Type:     schema.TypeBool,
@@ -176,9 +233,10 @@ func resourceE5EFunction() *schema.Resource {
 				Computed:    true,
 			},
 			"worker_type": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Worker type. Available worker types and their quota limits are provided by the `anxcloud_e5e_worker_types` data source.",
 			},
 			"revision": {
 				Type:     schema.TypeString,
@@ -186,14 +244,42 @@ func resourceE5EFunction() *schema.Resource {
 				Description: "Revision is an optional attribute which can be used to trigger a new deployment." +
 					" The value can be any arbitrary string (e.g. `COMMIT_SHA` or md5 hash of the code binary passed in via variables).",
 			},
-		},
+			"source_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Hash of the files packaged from `storage_backend_archive.source_dir`. A change triggers a new deployment.",
+			},
+			"deployment_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the latest deployment. A failed deployment is retried on the next apply when `revision` or `storage_backend_archive.source_dir` is set.",
+			},
+			"deployed_revision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Value of `revision` at the last successful deployment.",
+			},
+			"last_deployment_error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Error reported by the latest deployment, if it failed.",
+			},
+			"last_deployment_log": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Excerpt of the log of the latest deployment, if it failed.",
+			},
+		}),
 	}
 }
 
 func resourceE5EFunctionCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	a := apiFromProviderConfig(m)
 
-	function := e5eFunctionFromResourceData(d)
+	function, err := e5eFunctionFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	if err := a.Create(ctx, &function); err != nil {
 		return diag.Errorf("failed to create resource: %s", err)
@@ -201,9 +287,13 @@ func resourceE5EFunctionCreate(ctx context.Context, d *schema.ResourceData, m an
 
 	d.SetId(function.Identifier)
 
-	if d.HasChange("revision") {
-		if err := resourceE5EFunctionDeploy(ctx, a, function.Identifier); err != nil {
-			return diag.Errorf("deploy function: %s", err)
+	if e5eFunctionDeploymentPlanned(d) {
+		if err := resourceE5EFunctionDeploy(ctx, a, function.Identifier, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return append(diag.Errorf("deploy function: %s", err), resourceE5EFunctionRead(ctx, d, m)...)
+		}
+
+		if err := d.Set("deployed_revision", d.Get("revision")); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -221,21 +311,50 @@ func resourceE5EFunctionRead(ctx context.Context, d *schema.ResourceData, m any)
 		return nil
 	}
 
-	return e5eFunctionToResourceData(function, d)
+	diags := e5eFunctionToResourceData(function, d)
+
+	var deploymentError, deploymentLog string
+	if e5eFunctionDeploymentFailed(function.DeploymentState) {
+		deployment, err := latestE5EFunctionDeployment(ctx, a, function.Identifier)
+		if err != nil {
+			return append(diags, diag.Errorf("failed to retrieve latest deployment: %s", err)...)
+		}
+
+		if deployment != nil {
+			deploymentError = deployment.Error
+			deploymentLog = e5eDeploymentLogExcerpt(deployment.Log)
+		}
+	}
+
+	if err := d.Set("last_deployment_error", deploymentError); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("last_deployment_log", deploymentLog); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
 }
 
 func resourceE5EFunctionUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	a := apiFromProviderConfig(m)
 
-	function := e5eFunctionFromResourceData(d)
+	function, err := e5eFunctionFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	if err := a.Update(ctx, &function); err != nil {
 		return diag.Errorf("failed to update resource: %s", err)
 	}
 
-	if d.HasChange("revision") {
-		if err := resourceE5EFunctionDeploy(ctx, a, function.Identifier); err != nil {
-			return diag.Errorf("deploy function: %s", err)
+	if e5eFunctionDeploymentPlanned(d) {
+		if err := resourceE5EFunctionDeploy(ctx, a, function.Identifier, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return append(diag.Errorf("deploy function: %s", err), resourceE5EFunctionRead(ctx, d, m)...)
+		}
+
+		if err := d.Set("deployed_revision", d.Get("revision")); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -252,11 +371,176 @@ func resourceE5EFunctionDelete(ctx context.Context, d *schema.ResourceData, m an
 	return nil
 }
 
-func resourceE5EFunctionDeploy(ctx context.Context, a api.API, id string) error {
+// resourceE5EFunctionCustomizeSourceHash packages the configured source_dir and plans a new
+// source_hash whenever the packaged files changed.
+func resourceE5EFunctionCustomizeSourceHash(ctx context.Context, d *schema.ResourceDiff, m any) error {
+	if !d.NewValueKnown("storage_backend_archive.0.source_dir") {
+		return d.SetNewComputed("source_hash")
+	}
+
+	sourceDir, ok := d.GetOk("storage_backend_archive.0.source_dir")
+	if !ok {
+		if d.Get("source_hash").(string) != "" {
+			return d.SetNew("source_hash", "")
+		}
+		return nil
+	}
+
+	_, hash, err := buildE5EFunctionSourceArchive(
+		sourceDir.(string),
+		expandE5EFunctionSourcePatterns(d.Get("storage_backend_archive.0.include").([]any)),
+		expandE5EFunctionSourcePatterns(d.Get("storage_backend_archive.0.exclude").([]any)),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to package source_dir: %w", err)
+	}
+
+	if hash != d.Get("source_hash").(string) {
+		return d.SetNew("source_hash", hash)
+	}
+
+	return nil
+}
+
+// resourceE5EFunctionCustomizeDeployment marks the deployment attributes as unknown when
+// the function is going to be deployed.
+func resourceE5EFunctionCustomizeDeployment(ctx context.Context, d *schema.ResourceDiff, m any) error {
+	if !e5eFunctionDeploymentPlanned(d) {
+		return nil
+	}
+
+	for _, key := range []string{"deployment_state", "deployed_revision", "last_deployment_error", "last_deployment_log"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceE5EFunctionCustomizeCatalog validates runtime, worker type and quotas against the
+// catalog of the e5e platform when one of them changes.
+func resourceE5EFunctionCustomizeCatalog(ctx context.Context, d *schema.ResourceDiff, m any) error {
+	keys := []string{"runtime", "worker_type", "quota_memory", "quota_cpu", "quota_timeout"}
+
+	if d.Id() != "" && !d.HasChanges(keys...) {
+		return nil
+	}
+
+	for _, key := range keys {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	pc, ok := m.(providerContext)
+	if !ok || pc.e5eCatalog == nil {
+		return nil
+	}
+
+	// the catalog is only used for early validation, the API validates the function anyway
+	runtimes, err := pc.e5eCatalog.Runtimes(ctx, pc.api)
+	if err != nil {
+		log.Printf("[WARN] skipping validation of e5e function against the available runtimes: %s", err)
+		return nil
+	}
+
+	workerTypes, err := pc.e5eCatalog.WorkerTypes(ctx, pc.api)
+	if err != nil {
+		log.Printf("[WARN] skipping validation of e5e function against the available worker types: %s", err)
+		return nil
+	}
+
+	return validateE5EFunctionCatalog(
+		runtimes,
+		workerTypes,
+		d.Get("runtime").(string),
+		d.Get("worker_type").(string),
+		d.Get("quota_memory").(int),
+		d.Get("quota_cpu").(int),
+		d.Get("quota_timeout").(int),
+	)
+}
+
+// resourceE5EFunctionCustomizeEnvironment validates the values of the environment variables.
+// Write-only values are not part of the planned state, so they are taken from the configuration.
+func resourceE5EFunctionCustomizeEnvironment(ctx context.Context, d *schema.ResourceDiff, m any) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	return validateE5EFunctionEnvironment(config.GetAttr("env"))
+}
+
+// validateE5EFunctionEnvironment checks that secret environment variables have either a value
+// or a write-only value and that write-only values are only used for secrets
+func validateE5EFunctionEnvironment(env cty.Value) error {
+	if env.IsNull() || !env.IsKnown() || !env.CanIterateElements() {
+		return nil
+	}
+
+	for it := env.ElementIterator(); it.Next(); {
+		_, variable := it.Element()
+		if variable.IsNull() || !variable.IsKnown() {
+			continue
+		}
+
+		name, secret := variable.GetAttr("name"), variable.GetAttr("secret")
+		if !secret.IsKnown() {
+			continue
+		}
+
+		isSecret := !secret.IsNull() && secret.True()
+		hasValue := !variable.GetAttr("value").IsNull()
+		hasWriteOnlyValue := !variable.GetAttr("value_wo").IsNull()
+
+		displayName := "<unknown>"
+		if name.IsKnown() && !name.IsNull() {
+			displayName = name.AsString()
+		}
+
+		if isSecret && !hasValue && !hasWriteOnlyValue {
+			return fmt.Errorf("environment variable %q: either value or value_wo is required for secret environment variables", displayName)
+		}
+
+		if !isSecret && hasWriteOnlyValue {
+			return fmt.Errorf("environment variable %q: value_wo is only supported for secret environment variables", displayName)
+		}
+	}
+
+	return nil
+}
+
+// e5eFunctionChangeGetter is implemented by both schema.ResourceData and schema.ResourceDiff
+type e5eFunctionChangeGetter interface {
+	Get(string) any
+	GetChange(string) (any, any)
+	HasChanges(...string) bool
+}
+
+// e5eFunctionDeploymentPlanned returns true if the revision or the packaged source changed, or
+// if the previous deployment of a function with a configured deployment trigger failed.
+func e5eFunctionDeploymentPlanned(d e5eFunctionChangeGetter) bool {
+	if d.HasChanges("revision", "source_hash") {
+		return true
+	}
+
+	hasTrigger := d.Get("revision").(string) != "" || d.Get("storage_backend_archive.0.source_dir").(string) != ""
+	previousState, _ := d.GetChange("deployment_state")
+
+	return hasTrigger && e5eFunctionDeploymentFailed(previousState.(string))
+}
+
+func e5eFunctionDeploymentFailed(state string) bool {
+	return state != "" && state != "pending" && state != "deployed"
+}
+
+func resourceE5EFunctionDeploy(ctx context.Context, a api.API, id string, timeout time.Duration) error {
 	if err := a.Create(ctx, &e5ev1internal.E5EFunctionDeployment{FunctionIdentifier: id}); err != nil {
 		return err
 	}
-	return retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		function := e5ev1.Function{Identifier: id}
 		if err := a.Get(ctx, &function); err != nil {
 			return retry.NonRetryableError(err)
@@ -267,13 +551,32 @@ func resourceE5EFunctionDeploy(ctx context.Context, a api.API, id string) error
 		}
 
 		if function.DeploymentState != "deployed" {
-			return retry.NonRetryableError(fmt.Errorf("unexpected deployment state %q", function.DeploymentState))
+			err := fmt.Errorf("unexpected deployment state %q", function.DeploymentState)
+
+			if deployment, lookupErr := latestE5EFunctionDeployment(ctx, a, id); lookupErr != nil {
+				err = fmt.Errorf("%w, failed to retrieve deployment details: %s", err, lookupErr)
+			} else if deployment != nil {
+				err = fmt.Errorf("%w: %s\n\ndeployment log excerpt:\n%s", err, deployment.Error, e5eDeploymentLogExcerpt(deployment.Log))
+			}
+
+			return retry.NonRetryableError(err)
 		}
 
 		return nil
 	})
 }
 
+// e5eDeploymentLogExcerptLines is the number of trailing log lines kept from failed deployments
+const e5eDeploymentLogExcerptLines = 20
+
+func e5eDeploymentLogExcerpt(log string) string {
+	lines := strings.Split(strings.TrimRight(log, "\n"), "\n")
+	if len(lines) > e5eDeploymentLogExcerptLines {
+		lines = lines[len(lines)-e5eDeploymentLogExcerptLines:]
+	}
+	return strings.Join(lines, "\n")
+}
+
 func e5eFunctionToResourceData(function e5ev1.Function, d *schema.ResourceData) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -294,6 +597,12 @@ func e5eFunctionToResourceData(function e5ev1.Function, d *schema.ResourceData)
 	setVal("quota_timeout", function.QuotaTimeout)
 	setVal("quota_concurrency", function.QuotaConcurrency)
 	setVal("worker_type", function.WorkerType)
+	setVal("deployment_state", function.DeploymentState)
+
+	// adopt the configured revision for functions deployed before deployed_revision was tracked
+	if d.Get("deployed_revision").(string) == "" && function.DeploymentState == "deployed" {
+		setVal("deployed_revision", d.Get("revision"))
+	}
 
 	// set storage backend
 	switch function.StorageBackend {
@@ -339,10 +648,10 @@ func e5eFunctionToResourceData(function e5ev1.Function, d *schema.ResourceData)
 
 	// e5e function api does not return the values of secret environment variables
 	// therefore we retrieve the old environment variables to be used instead
-	previousEnvMap := map[string]string{}
+	previousEnvMap := map[string]map[string]any{}
 	for _, env := range d.Get("env").([]any) {
 		env := env.(map[string]any)
-		previousEnvMap[env["name"].(string)] = env["value"].(string)
+		previousEnvMap[env["name"].(string)] = env
 	}
 
 	var envVars []map[string]any
@@ -352,9 +661,10 @@ func e5eFunctionToResourceData(function e5ev1.Function, d *schema.ResourceData)
 			"value":  envVar.Value,
 			"secret": envVar.Secret,
 		}
-		if envVar.Secret {
-			if val, ok := previousEnvMap[envVar.Name]; ok {
-				envVarMap["value"] = val
+		if previous, ok := previousEnvMap[envVar.Name]; ok {
+			envVarMap["value_wo_version"] = previous["value_wo_version"]
+			if envVar.Secret {
+				envVarMap["value"] = previous["value"]
 			}
 		}
 		envVars = append(envVars, envVarMap)
@@ -374,7 +684,7 @@ func e5eFunctionToResourceData(function e5ev1.Function, d *schema.ResourceData)
 	return diags
 }
 
-func e5eFunctionFromResourceData(d *schema.ResourceData) e5ev1.Function {
+func e5eFunctionFromResourceData(d *schema.ResourceData) (e5ev1.Function, error) {
 	function := e5ev1.Function{
 		Identifier:            d.Id(),
 		Name:                  d.Get("name").(string),
@@ -393,11 +703,22 @@ func e5eFunctionFromResourceData(d *schema.ResourceData) e5ev1.Function {
 
 	if envVariables, ok := d.GetOk("env"); ok {
 		vars := []e5ev1.EnvironmentVariable{}
-		for _, variable := range envVariables.([]any) {
+		for i, variable := range envVariables.([]any) {
 			variable := variable.(map[string]any)
+			value := variable["value"].(string)
+
+			// write-only values are not stored in the state, they are only available in the configuration
+			valueWO, diags := d.GetRawConfigAt(cty.GetAttrPath("env").IndexInt(i).GetAttr("value_wo"))
+			if !diags.HasError() && valueWO.Type() == cty.String && valueWO.IsKnown() && !valueWO.IsNull() {
+				if !variable["secret"].(bool) {
+					return function, fmt.Errorf("environment variable %q: value_wo is only supported for secret environment variables", variable["name"])
+				}
+				value = valueWO.AsString()
+			}
+
 			vars = append(vars, e5ev1.EnvironmentVariable{
 				Name:   variable["name"].(string),
-				Value:  variable["value"].(string),
+				Value:  value,
 				Secret: variable["secret"].(bool),
 			})
 		}
@@ -446,12 +767,30 @@ func e5eFunctionFromResourceData(d *schema.ResourceData) e5ev1.Function {
 			Password:   meta["password"].(string),
 		}
 	} else if meta, ok := getStorageBackendMeta("storage_backend_archive"); ok {
-		function.StorageBackend = "archive"
-		function.StorageBackendMeta.StorageBackendMetaArchive = &e5ev1.StorageBackendMetaArchive{
+		archive := &e5ev1.StorageBackendMetaArchive{
 			Content: meta["content"].(string),
 			Name:    meta["name"].(string),
 		}
+
+		if sourceDir := meta["source_dir"].(string); sourceDir != "" {
+			content, _, err := buildE5EFunctionSourceArchive(
+				sourceDir,
+				expandE5EFunctionSourcePatterns(meta["include"].([]any)),
+				expandE5EFunctionSourcePatterns(meta["exclude"].([]any)),
+			)
+			if err != nil {
+				return function, fmt.Errorf("failed to package source_dir: %w", err)
+			}
+
+			archive.Content = e5eFunctionSourceArchiveContent(content)
+			if archive.Name == "" {
+				archive.Name = e5eFunctionSourceArchiveName(sourceDir)
+			}
+		}
+
+		function.StorageBackend = "archive"
+		function.StorageBackendMeta.StorageBackendMetaArchive = archive
 	}
 
-	return function
+	return function, nil
 }
@@ -9,7 +9,9 @@ import (
 	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/testutils/environment"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	e5ev1 "go.anx.io/go-anxcloud/pkg/apis/e5e/v1"
 )
@@ -44,6 +46,25 @@ func TestAccAnxCloudE5EApplication(t *testing.T) {
 	})
 }
 
+func TestAccAnxCloudE5EApplicationTags(t *testing.T) {
+	environment.SkipIfNoEnvironment(t)
+
+	tpl := fmt.Sprintf(`
+	resource "anxcloud_e5e_application" "foo" {
+		name = "terraform-test-tags-%s"
+
+		%%s // tags
+	}`, environment.GetEnvInfo(t).TestRunName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: testAccAnxCloudCommonResourceTagTestSteps(
+			tpl, "anxcloud_e5e_application.foo",
+		),
+	})
+}
+
 func TestAccAnxCloudE5EFunction(t *testing.T) {
 	environment.SkipIfNoEnvironment(t)
 
@@ -257,3 +278,104 @@ func testAccAnxCloudE5EFunctionRenderResource(config e5ev1.Function) string {
 		config.WorkerType,
 	)
 }
+
+func TestE5EFunctionSecretEnvironmentVariables(t *testing.T) {
+	// the API returns secret environment variables without their value
+	function := e5ev1.Function{
+		Identifier: "foo",
+		EnvironmentVariables: &[]e5ev1.EnvironmentVariable{
+			{Name: "secret", Value: "", Secret: true},
+			{Name: "secret_wo", Value: "", Secret: true},
+			{Name: "plain", Value: "remote", Secret: false},
+		},
+		Hostnames: &[]e5ev1.Hostname{},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceE5EFunction().Schema, map[string]any{
+		"env": []any{
+			map[string]any{"name": "secret", "value": "configured", "secret": true},
+			map[string]any{"name": "secret_wo", "value_wo_version": 2, "secret": true},
+			map[string]any{"name": "plain", "value": "configured"},
+		},
+	})
+
+	if diags := e5eFunctionToResourceData(function, d); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []any{
+		map[string]any{"name": "secret", "value": "configured", "value_wo": "", "value_wo_version": 0, "secret": true},
+		map[string]any{"name": "secret_wo", "value": "", "value_wo": "", "value_wo_version": 2, "secret": true},
+		map[string]any{"name": "plain", "value": "remote", "value_wo": "", "value_wo_version": 0, "secret": false},
+	}
+
+	if diff := cmp.Diff(expected, d.Get("env")); diff != "" {
+		t.Errorf("unexpected environment variables (-expected +actual):\n%s", diff)
+	}
+
+	// without configuration, e.g. in unit tests, the value attribute is used
+	expanded, err := e5eFunctionFromResourceData(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if value := (*expanded.EnvironmentVariables)[0].Value; value != "configured" {
+		t.Errorf("expected secret value to be sent to the API, got %q", value)
+	}
+}
+
+func TestValidateE5EFunctionEnvironment(t *testing.T) {
+	variable := func(value, valueWO, secret cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"name":             cty.StringVal("foo"),
+			"value":            value,
+			"value_wo":         valueWO,
+			"value_wo_version": cty.NullVal(cty.Number),
+			"secret":           secret,
+		})
+	}
+
+	nullString := cty.NullVal(cty.String)
+
+	cases := []struct {
+		Name          string
+		Variable      cty.Value
+		ExpectedError string
+	}{
+		{"plain value", variable(cty.StringVal("bar"), nullString, cty.NullVal(cty.Bool)), ""},
+		{"plain without value", variable(nullString, nullString, cty.False), ""},
+		{"secret value", variable(cty.StringVal("bar"), nullString, cty.True), ""},
+		{"secret write-only value", variable(nullString, cty.StringVal("bar"), cty.True), ""},
+		{"unknown secret write-only value", variable(nullString, cty.UnknownVal(cty.String), cty.True), ""},
+		{"secret without value", variable(nullString, nullString, cty.True), "either value or value_wo is required"},
+		{"plain write-only value", variable(nullString, cty.StringVal("bar"), cty.False), "value_wo is only supported for secret"},
+		{"unknown secret flag", variable(nullString, nullString, cty.UnknownVal(cty.Bool)), ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := validateE5EFunctionEnvironment(cty.ListVal([]cty.Value{tc.Variable}))
+			if tc.ExpectedError == "" && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			} else if tc.ExpectedError != "" && (err == nil || !strings.Contains(err.Error(), tc.ExpectedError)) {
+				t.Errorf("expected error containing %q, got %v", tc.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func TestE5EDeploymentLogExcerpt(t *testing.T) {
+	lines := make([]string, 0, 30)
+	for i := 1; i <= 30; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+
+	excerpt := e5eDeploymentLogExcerpt(strings.Join(lines, "\n") + "\n")
+	if diff := cmp.Diff(strings.Join(lines[10:], "\n"), excerpt); diff != "" {
+		t.Errorf("unexpected log excerpt: mismatch (-want +got):\n%s", diff)
+	}
+
+	if excerpt := e5eDeploymentLogExcerpt("short log"); excerpt != "short log" {
+		t.Errorf("expected short logs to be kept, got %q", excerpt)
+	}
+}
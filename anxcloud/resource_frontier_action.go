@@ -27,15 +27,16 @@ func resourceFrontierAction() *schema.Resource {
 	return &schema.Resource{
 		Description: "An action is the lowest entity within Frontier's hierarchy and maps HTTP methods for an endpoint to action handlers." +
 			" Those action handlers may be e5e functions, other HTTP-based APIs or mock responses." +
-			" Referencing a non-existing e5e function will result in a 404 error.",
-		CreateContext: resourceFrontierActionCreate,
-		ReadContext:   resourceFrontierActionRead,
-		UpdateContext: resourceFrontierActionUpdate,
+			" Referencing a non-existing e5e function will result in a 404 error." +
+			" Request and response headers can't be modified, mock responses can't set a status code and URL rewrites have no timeout or upstream authentication settings, as the Frontier API client doesn't support them.",
+		CreateContext: tagsMiddlewareCreate(resourceFrontierActionCreate),
+		ReadContext:   tagsMiddlewareRead(resourceFrontierActionRead),
+		UpdateContext: tagsMiddlewareUpdate(resourceFrontierActionUpdate),
 		DeleteContext: resourceFrontierActionDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: map[string]*schema.Schema{
+		Schema: withTagsAttribute(map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -52,80 +53,91 @@ func resourceFrontierAction() *schema.Resource {
 				Description: "Action HTTP request method.",
 			},
 			"url_rewrite": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Forwards requests to another HTTP-based API. Timeouts and upstream authentication are not supported.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"url": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "URL requests are forwarded to.",
 						},
 					},
 				},
 				ConflictsWith: frontierActionTypesExcept(frontierv1.ActionTypeURLRewrite),
 			},
 			"mock_response": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Responds with a static body. The status code can't be configured.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"body": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Response body.",
 						},
 						"language": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Language of the response body, e.g. `plaintext` or `json`.",
 						},
 					},
 				},
 				ConflictsWith: frontierActionTypesExcept(frontierv1.ActionTypeMockResponse),
 			},
 			"e5e_function": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Invokes an e5e function synchronously and responds with its result.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"function": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Identifier of the e5e function.",
 						},
 					},
 				},
 				ConflictsWith: frontierActionTypesExcept(frontierv1.ActionTypeE5EFunction),
 			},
 			"e5e_async_function": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Invokes an e5e function asynchronously and responds with an identifier to retrieve the result with.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"function": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Identifier of the e5e function.",
 						},
 					},
 				},
 				ConflictsWith: frontierActionTypesExcept(frontierv1.ActionTypeE5EAsyncFunction),
 			},
 			"e5e_async_result": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Responds with the result of an asynchronous invocation of an e5e function.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"function": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Identifier of the e5e function.",
 						},
 					},
 				},
 				ConflictsWith: frontierActionTypesExcept(frontierv1.ActionTypeE5EAsyncResult),
 			},
-		},
+		}),
 	}
 }
 
@@ -206,7 +218,15 @@ func resourceFrontierActionRead(ctx context.Context, d *schema.ResourceData, m a
 	if err := d.Set("http_request_method", frontierAction.HTTPRequestMethod); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
+	if err := d.Set(string(frontierAction.Type), flattenFrontierActionMeta(frontierAction)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
 
+// flattenFrontierActionMeta returns the value of the handler block matching the type of an action
+func flattenFrontierActionMeta(frontierAction frontierv1.Action) []any {
 	var metaValue []any
 	switch frontierAction.Type {
 	case frontierv1.ActionTypeMockResponse:
@@ -232,11 +252,7 @@ func resourceFrontierActionRead(ctx context.Context, d *schema.ResourceData, m a
 		}}
 	}
 
-	if err := d.Set(string(frontierAction.Type), metaValue); err != nil {
-		diags = append(diags, diag.FromErr(err)...)
-	}
-
-	return diags
+	return metaValue
 }
 
 func resourceFrontierActionUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -14,15 +14,16 @@ import (
 
 func resourceFrontierAPI() *schema.Resource {
 	return &schema.Resource{
-		Description:   "An API represents Frontier's root object and contains a collection of endpoints. The API defines the transfer protocol, such as HTTP and HTTPS, for all containing endpoints.",
-		CreateContext: resourceFrontierAPICreate,
-		ReadContext:   resourceFrontierAPIRead,
-		UpdateContext: resourceFrontierAPIUpdate,
+		Description: "An API represents Frontier's root object and contains a collection of endpoints. The API defines the transfer protocol, such as HTTP and HTTPS, for all containing endpoints." +
+			" CORS and authentication can't be configured, the Frontier API client doesn't support them.",
+		CreateContext: tagsMiddlewareCreate(resourceFrontierAPICreate),
+		ReadContext:   tagsMiddlewareRead(resourceFrontierAPIRead),
+		UpdateContext: tagsMiddlewareUpdate(resourceFrontierAPIUpdate),
 		DeleteContext: resourceFrontierAPIDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: map[string]*schema.Schema{
+		Schema: withTagsAttribute(map[string]*schema.Schema{
 
 			"id": {
 				Type:        schema.TypeString,
@@ -45,7 +46,7 @@ func resourceFrontierAPI() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"http"}, false),
 				Description:  "API transfer protocol. Currently `http` is the only supported value.",
 			},
-		},
+		}),
 	}
 }
 
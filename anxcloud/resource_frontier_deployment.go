@@ -6,20 +6,53 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"go.anx.io/go-anxcloud/pkg/api"
 	frontierv1 "go.anx.io/go-anxcloud/pkg/apis/frontier/v1"
+	"strings"
+	"time"
 )
 
+// frontierBaseURL is the URL under which all Frontier deployments are published
+const frontierBaseURL = "https://frontier.anexia-it.com"
+
+// frontierDeploymentURL returns the public URL of a deployment
+func frontierDeploymentURL(deployment frontierv1.Deployment) string {
+	return fmt.Sprintf("%s/%s/%s", frontierBaseURL, deployment.APIIdentifier, deployment.Slug)
+}
+
+// frontierEndpointURLs returns the public URLs of all endpoints of the deployed API by path
+func frontierEndpointURLs(ctx context.Context, a api.API, deployment frontierv1.Deployment) (map[string]string, error) {
+	endpoints, err := listFrontierEndpoints(ctx, a, deployment.APIIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make(map[string]string, len(endpoints))
+	for _, endpoint := range endpoints {
+		path := strings.TrimPrefix(endpoint.Path, "/")
+		urls["/"+path] = frontierDeploymentURL(deployment) + "/" + path
+	}
+
+	return urls, nil
+}
+
 func resourceFrontierDeployment() *schema.Resource {
 	return &schema.Resource{
-		Description:   "A deployment represents a published version of a Frontier API with all its endpoints and actions exactly as it was at the time it was deployed.",
-		CreateContext: resourceFrontierDeploymentCreate,
-		ReadContext:   resourceFrontierDeploymentRead,
+		Description: "A deployment represents a published version of a Frontier API with all its endpoints and actions exactly as it was at the time it was deployed." +
+			" The endpoints and actions of the API are compared with the deployed version on every refresh and the API is redeployed when they changed." +
+			" Redeployments create a new deployment before the previous one is deleted, so there is always a deployment present." +
+			" Changes to endpoints and actions made in the same apply are only detected by the next one, unless `revision` changes as well," +
+			" e.g. by setting it to the `fingerprint` of an `anxcloud_frontier_openapi` resource.",
+		CreateContext: tagsMiddlewareCreate(resourceFrontierDeploymentCreate),
+		ReadContext:   tagsMiddlewareRead(resourceFrontierDeploymentRead),
+		UpdateContext: tagsMiddlewareUpdate(resourceFrontierDeploymentUpdate),
 		DeleteContext: resourceFrontierDeploymentDelete,
+		CustomizeDiff: resourceFrontierDeploymentCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: map[string]*schema.Schema{
+		Schema: withTagsAttribute(map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -50,30 +83,80 @@ func resourceFrontierDeployment() *schema.Resource {
 			"revision": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Description: "Deployment revision is an optional attribute which can be used to trigger a new deployment." +
-					" Use the `create_before_destroy` lifecycle argument to ensure that there is always a deployment present." +
 					" The value can be any arbitrary string (e.g. `COMMIT_SHA` passed in via variables).",
 			},
-		},
+			"retain_deployments": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of previous deployments to keep after a redeployment. Older deployments created by this resource are deleted.",
+			},
+			"previous_deployments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Identifiers of the retained previous deployments, newest first.",
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Fingerprint of the current endpoints and actions of the API.",
+			},
+			"deployed_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Fingerprint of the endpoints and actions of the API at the time it was deployed. The API is redeployed when it differs from `fingerprint`.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Public URL of the deployed API.",
+			},
+			"endpoint_urls": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Public URLs of the endpoints of the API by path, e.g. `/pets/{id}`. Endpoints added to the API after the deployment was created are listed as well.",
+			},
+		}),
 	}
 }
 
-func resourceFrontierDeploymentCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	a := apiFromProviderConfig(m)
+func resourceFrontierDeploymentCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	// fingerprint is refreshed on read, so a difference means endpoints or actions changed since the last deployment
+	if fingerprint := d.Get("fingerprint").(string); fingerprint != d.Get("deployed_fingerprint").(string) {
+		if err := d.SetNew("deployed_fingerprint", fingerprint); err != nil {
+			return err
+		}
+	}
+
+	// redeploying creates a new deployment
+	if d.HasChanges("revision", "deployed_fingerprint") {
+		return d.SetNewComputed("id")
+	}
 
+	return nil
+}
+
+// deployFrontierAPI creates a new deployment of an API and waits until it is deployed.
+// The returned deployment has an identifier set even if waiting for it failed.
+func deployFrontierAPI(ctx context.Context, a api.API, apiID, slug string, timeout time.Duration) (frontierv1.Deployment, error) {
 	frontierDeployment := frontierv1.Deployment{
-		APIIdentifier: d.Get("api").(string),
-		Slug:          d.Get("slug").(string),
+		APIIdentifier: apiID,
+		Slug:          slug,
 	}
 
 	if err := a.Create(ctx, &frontierDeployment); err != nil {
-		return diag.Errorf("failed to create resource: %s", err)
+		return frontierDeployment, fmt.Errorf("failed to create resource: %w", err)
 	}
 
-	d.SetId(frontierDeployment.Identifier)
-
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		if err := a.Get(ctx, &frontierDeployment); err != nil {
 			return retry.NonRetryableError(err)
 		}
@@ -88,10 +171,47 @@ func resourceFrontierDeploymentCreate(ctx context.Context, d *schema.ResourceDat
 
 		return nil
 	})
+
+	return frontierDeployment, err
+}
+
+// destroyFrontierDeployment deletes a deployment and waits until it is gone
+func destroyFrontierDeployment(ctx context.Context, a api.API, id string, timeout time.Duration) error {
+	if err := a.Destroy(ctx, &frontierv1.Deployment{Identifier: id}); api.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed deleting resource: %w", err)
+	}
+
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		if err := a.Get(ctx, &frontierv1.Deployment{Identifier: id}); api.IgnoreNotFound(err) != nil {
+			return retry.NonRetryableError(err)
+		} else if err != nil {
+			return nil
+		}
+		return retry.RetryableError(fmt.Errorf("resource still deleting"))
+	})
+}
+
+func resourceFrontierDeploymentCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+	apiID := d.Get("api").(string)
+
+	fingerprint, err := frontierAPIFingerprint(ctx, a, apiID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	frontierDeployment, err := deployFrontierAPI(ctx, a, apiID, d.Get("slug").(string), d.Timeout(schema.TimeoutCreate))
+	if frontierDeployment.Identifier != "" {
+		d.SetId(frontierDeployment.Identifier)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("deployed_fingerprint", fingerprint); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceFrontierDeploymentRead(ctx, d, m)
 }
 
@@ -120,23 +240,113 @@ func resourceFrontierDeploymentRead(ctx context.Context, d *schema.ResourceData,
 	if err := d.Set("state", frontierDeployment.State); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
+	if err := d.Set("url", frontierDeploymentURL(frontierDeployment)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if endpointURLs, err := frontierEndpointURLs(ctx, a, frontierDeployment); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else if err := d.Set("endpoint_urls", endpointURLs); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	fingerprint, err := frontierAPIFingerprint(ctx, a, frontierDeployment.APIIdentifier)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("fingerprint", fingerprint); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	// deployments created by older provider versions or imported ones are assumed to be up to date
+	if d.Get("deployed_fingerprint").(string) == "" {
+		if err := d.Set("deployed_fingerprint", fingerprint); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
 
 	return diags
 }
 
+func resourceFrontierDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	previousDeployments := expandFrontierDeploymentIdentifiers(d.Get("previous_deployments").([]any))
+
+	if d.HasChanges("revision", "deployed_fingerprint") {
+		apiID := d.Get("api").(string)
+
+		// state has to describe the previous deployment when redeploying fails, so it is planned again
+		restorePreviousDeployment := func() {
+			for _, key := range []string{"revision", "deployed_fingerprint"} {
+				previous, _ := d.GetChange(key)
+				_ = d.Set(key, previous)
+			}
+		}
+
+		fingerprint, err := frontierAPIFingerprint(ctx, a, apiID)
+		if err != nil {
+			restorePreviousDeployment()
+			return diag.FromErr(err)
+		}
+
+		frontierDeployment, err := deployFrontierAPI(ctx, a, apiID, d.Get("slug").(string), timeout)
+		if err != nil {
+			// the previous deployment is still serving requests, the failed one is removed on a best effort basis
+			if frontierDeployment.Identifier != "" {
+				_ = destroyFrontierDeployment(ctx, a, frontierDeployment.Identifier, timeout)
+			}
+			restorePreviousDeployment()
+			return diag.FromErr(err)
+		}
+
+		previousDeployments = append([]string{d.Id()}, previousDeployments...)
+		d.SetId(frontierDeployment.Identifier)
+
+		if err := d.Set("deployed_fingerprint", fingerprint); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	retain := d.Get("retain_deployments").(int)
+	for len(previousDeployments) > retain {
+		last := len(previousDeployments) - 1
+		if err := destroyFrontierDeployment(ctx, a, previousDeployments[last], timeout); err != nil {
+			_ = d.Set("previous_deployments", previousDeployments)
+			return diag.Errorf("failed deleting previous deployment %q: %s", previousDeployments[last], err)
+		}
+		previousDeployments = previousDeployments[:last]
+	}
+
+	if err := d.Set("previous_deployments", previousDeployments); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceFrontierDeploymentRead(ctx, d, m)
+}
+
 func resourceFrontierDeploymentDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	a := apiFromProviderConfig(m)
+	timeout := d.Timeout(schema.TimeoutDelete)
 
-	if err := a.Destroy(ctx, &frontierv1.Deployment{Identifier: d.Id()}); api.IgnoreNotFound(err) != nil {
-		return diag.Errorf("failed deleting resource: %s", err)
+	deployments := append([]string{d.Id()}, expandFrontierDeploymentIdentifiers(d.Get("previous_deployments").([]any))...)
+	for _, id := range deployments {
+		if err := destroyFrontierDeployment(ctx, a, id, timeout); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	return diag.FromErr(retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
-		if err := a.Get(ctx, &frontierv1.Deployment{Identifier: d.Id()}); api.IgnoreNotFound(err) != nil {
-			return retry.NonRetryableError(err)
-		} else if err != nil {
-			return nil
+	return nil
+}
+
+func expandFrontierDeploymentIdentifiers(in []any) []string {
+	identifiers := make([]string, 0, len(in))
+	for _, id := range in {
+		if id, ok := id.(string); ok && id != "" {
+			identifiers = append(identifiers, id)
 		}
-		return retry.RetryableError(fmt.Errorf("resource still deleting"))
-	}))
+	}
+	return identifiers
 }
@@ -0,0 +1,118 @@
+package anxcloud
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/mockapi"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+
+	frontierv1 "go.anx.io/go-anxcloud/pkg/apis/frontier/v1"
+)
+
+func TestResourceFrontierDeploymentRedeployFailure(t *testing.T) {
+	cases := []struct {
+		Name            string
+		FailList        bool
+		FailCreate      bool
+		ExpectDestroyed []string
+	}{
+		{"fingerprinting fails", true, false, nil},
+		{"creating deployment fails", false, true, nil},
+		{"deployment fails", false, false, []string{"new"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			a := mockapi.NewMockAPI(ctrl)
+
+			d, err := schema.InternalMap(resourceFrontierDeployment().Schema).Data(
+				&terraform.InstanceState{
+					ID: "current",
+					Attributes: map[string]string{
+						"id":                   "current",
+						"api":                  "api",
+						"slug":                 "slug",
+						"revision":             "1",
+						"fingerprint":          "current-fingerprint",
+						"deployed_fingerprint": "current-fingerprint",
+					},
+				},
+				&terraform.InstanceDiff{
+					Attributes: map[string]*terraform.ResourceAttrDiff{
+						"revision": {Old: "1", New: "2"},
+					},
+				},
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			a.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, o types.FilterObject, opts ...types.ListOption) error {
+				if tc.FailList {
+					return errors.New("list failed")
+				}
+
+				var options types.ListOptions
+				for _, opt := range opts {
+					if err := opt.ApplyToList(&options); err != nil {
+						return err
+					}
+				}
+
+				// API without any endpoints
+				channel := make(types.ObjectChannel)
+				close(channel)
+				*options.ObjectChannel = channel
+
+				return nil
+			})
+			a.EXPECT().Create(gomock.Any(), gomock.Any()).MaxTimes(1).DoAndReturn(func(ctx context.Context, o types.Object, opts ...types.CreateOption) error {
+				if tc.FailCreate {
+					return errors.New("create failed")
+				}
+				o.(*frontierv1.Deployment).Identifier = "new"
+				return nil
+			})
+
+			destroyed := []string{}
+			a.EXPECT().Destroy(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, o types.IdentifiedObject, opts ...types.DestroyOption) error {
+				destroyed = append(destroyed, o.(*frontierv1.Deployment).Identifier)
+				return nil
+			})
+			a.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, o types.IdentifiedObject, opts ...types.GetOption) error {
+				if len(destroyed) > 0 {
+					return api.ErrNotFound
+				}
+				o.(*frontierv1.Deployment).State = "failed"
+				return nil
+			})
+
+			if diags := resourceFrontierDeploymentUpdate(context.TODO(), d, providerContext{api: a}); !diags.HasError() {
+				t.Fatal("expected an error")
+			}
+
+			if d.Id() != "current" {
+				t.Errorf("expected state to track the current deployment, got %q", d.Id())
+			}
+
+			if revision := d.Get("revision").(string); revision != "1" {
+				t.Errorf("expected revision of the current deployment, got %q", revision)
+			}
+
+			if fingerprint := d.Get("deployed_fingerprint").(string); fingerprint != "current-fingerprint" {
+				t.Errorf("expected fingerprint of the current deployment, got %q", fingerprint)
+			}
+
+			if len(destroyed) != len(tc.ExpectDestroyed) || (len(destroyed) > 0 && destroyed[0] != tc.ExpectDestroyed[0]) {
+				t.Errorf("expected deployments %v to be deleted, got %v", tc.ExpectDestroyed, destroyed)
+			}
+		})
+	}
+}
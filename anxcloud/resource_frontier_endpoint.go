@@ -11,14 +11,14 @@ import (
 func resourceFrontierEndpoint() *schema.Resource {
 	return &schema.Resource{
 		Description:   "An endpoint represents a path within an HTTP-based API and contains a collection of actions.",
-		CreateContext: resourceFrontierEndpointCreate,
-		ReadContext:   resourceFrontierEndpointRead,
-		UpdateContext: resourceFrontierEndpointUpdate,
+		CreateContext: tagsMiddlewareCreate(resourceFrontierEndpointCreate),
+		ReadContext:   tagsMiddlewareRead(resourceFrontierEndpointRead),
+		UpdateContext: tagsMiddlewareUpdate(resourceFrontierEndpointUpdate),
 		DeleteContext: resourceFrontierEndpointDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: map[string]*schema.Schema{
+		Schema: withTagsAttribute(map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -39,7 +39,7 @@ func resourceFrontierEndpoint() *schema.Resource {
 				Required:    true,
 				Description: "Endpoint API identifier.",
 			},
-		},
+		}),
 	}
 }
 
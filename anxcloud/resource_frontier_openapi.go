@@ -0,0 +1,358 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/api"
+	frontierv1 "go.anx.io/go-anxcloud/pkg/apis/frontier/v1"
+)
+
+func resourceFrontierOpenAPI() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages all endpoints and actions of a Frontier API from an OpenAPI 3 specification." +
+			" Every path of the specification becomes an endpoint, named after the path's `summary`, and every operation an action." +
+			" Operations configure their handler with the `x-frontier-action` vendor extension, which contains exactly one of" +
+			" `url_rewrite`, `mock_response`, `e5e_function`, `e5e_async_function` or `e5e_async_result` with the same attributes as" +
+			" the respective block of `anxcloud_frontier_action`. Endpoints and actions of the API which are not part of the" +
+			" specification are deleted, so the API must not be managed with `anxcloud_frontier_endpoint` or `anxcloud_frontier_action` at the same time.",
+		CreateContext: resourceFrontierOpenAPICreate,
+		ReadContext:   resourceFrontierOpenAPIRead,
+		UpdateContext: resourceFrontierOpenAPIUpdate,
+		DeleteContext: resourceFrontierOpenAPIDelete,
+		CustomizeDiff: resourceFrontierOpenAPICustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"api": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the API the endpoints and actions are created in.",
+			},
+			"spec": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v any, k string) ([]string, []error) {
+					if _, err := parseFrontierOpenAPISpec(v.(string)); err != nil {
+						return nil, []error{fmt.Errorf("%q: %w", k, err)}
+					}
+					return nil, nil
+				},
+				Description: "OpenAPI 3 specification, encoded as JSON or YAML.",
+			},
+			"endpoints": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Endpoint identifiers by path, e.g. `/pets/{id}`.",
+			},
+			"actions": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Action identifiers by upper case method and path, e.g. `GET /pets/{id}`.",
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Fingerprint of all endpoints and actions of the API, changes whenever they change. Can be used as `revision` of `anxcloud_frontier_deployment`.",
+			},
+		},
+	}
+}
+
+type frontierRemoteEndpoint struct {
+	endpoint frontierv1.Endpoint
+	actions  []frontierv1.Action
+}
+
+// listFrontierAPITree returns all endpoints of an API together with their actions
+func listFrontierAPITree(ctx context.Context, a api.API, apiID string) ([]frontierRemoteEndpoint, error) {
+	endpoints, err := listFrontierEndpoints(ctx, a, apiID)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := make([]frontierRemoteEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		actions, err := listFrontierActions(ctx, a, endpoint.Identifier)
+		if err != nil {
+			return nil, err
+		}
+
+		tree = append(tree, frontierRemoteEndpoint{endpoint: endpoint, actions: actions})
+	}
+
+	return tree, nil
+}
+
+// frontierAPIFingerprint returns the fingerprint of the current endpoints and actions of an API
+func frontierAPIFingerprint(ctx context.Context, a api.API, apiID string) (string, error) {
+	remote, err := listFrontierAPITree(ctx, a, apiID)
+	if err != nil {
+		return "", err
+	}
+
+	return frontierOpenAPIFingerprint(frontierOpenAPIEndpointsFromRemote(remote)), nil
+}
+
+func frontierOpenAPIEndpointsFromRemote(remote []frontierRemoteEndpoint) []frontierOpenAPIEndpoint {
+	endpoints := make([]frontierOpenAPIEndpoint, 0, len(remote))
+
+	for _, r := range remote {
+		endpoint := frontierOpenAPIEndpoint{
+			Path: "/" + strings.TrimPrefix(r.endpoint.Path, "/"),
+			Name: r.endpoint.Name,
+		}
+
+		for _, action := range r.actions {
+			endpoint.Actions = append(endpoint.Actions, frontierOpenAPIActionFromAction(action))
+		}
+
+		endpoints = append(endpoints, endpoint)
+	}
+
+	sortFrontierOpenAPIEndpoints(endpoints)
+
+	return endpoints
+}
+
+func resourceFrontierOpenAPICustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("spec") {
+		for _, key := range []string{"endpoints", "actions", "fingerprint"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	endpoints, err := parseFrontierOpenAPISpec(d.Get("spec").(string))
+	if err != nil {
+		return err
+	}
+
+	if fingerprint := frontierOpenAPIFingerprint(endpoints); fingerprint != d.Get("fingerprint").(string) {
+		if err := d.SetNew("fingerprint", fingerprint); err != nil {
+			return err
+		}
+	}
+
+	// identifiers are only known after apply when endpoints or actions are added or removed
+	stateEndpoints := d.Get("endpoints").(map[string]any)
+	stateActions := d.Get("actions").(map[string]any)
+
+	actionCount := 0
+	keysMatch := len(stateEndpoints) == len(endpoints)
+
+	for _, endpoint := range endpoints {
+		if _, ok := stateEndpoints[endpoint.Path]; !ok {
+			keysMatch = false
+		}
+
+		for _, action := range endpoint.Actions {
+			actionCount++
+			if _, ok := stateActions[endpoint.actionKey(action)]; !ok {
+				keysMatch = false
+			}
+		}
+	}
+
+	if !keysMatch || actionCount != len(stateActions) {
+		for _, key := range []string{"endpoints", "actions"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceFrontierOpenAPICreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	d.SetId(d.Get("api").(string))
+
+	if err := resourceFrontierOpenAPIApply(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceFrontierOpenAPIRead(ctx, d, m)
+}
+
+func resourceFrontierOpenAPIUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if err := resourceFrontierOpenAPIApply(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceFrontierOpenAPIRead(ctx, d, m)
+}
+
+// resourceFrontierOpenAPIApply reconciles endpoints and actions of the API with the specification.
+// Existing endpoints and actions are matched by path and method and updated in place.
+func resourceFrontierOpenAPIApply(ctx context.Context, d *schema.ResourceData, m any) error {
+	a := apiFromProviderConfig(m)
+	apiID := d.Id()
+
+	desired, err := parseFrontierOpenAPISpec(d.Get("spec").(string))
+	if err != nil {
+		return err
+	}
+
+	remote, err := listFrontierAPITree(ctx, a, apiID)
+	if err != nil {
+		return err
+	}
+
+	remoteByPath := make(map[string]frontierRemoteEndpoint, len(remote))
+	var obsolete []frontierRemoteEndpoint
+
+	for _, r := range remote {
+		path := strings.TrimPrefix(r.endpoint.Path, "/")
+		if _, ok := remoteByPath[path]; ok {
+			obsolete = append(obsolete, r)
+			continue
+		}
+		remoteByPath[path] = r
+	}
+
+	for _, endpoint := range desired {
+		current, exists := remoteByPath[endpoint.frontierPath()]
+		delete(remoteByPath, endpoint.frontierPath())
+
+		frontierEndpoint := frontierv1.Endpoint{
+			Identifier:    current.endpoint.Identifier,
+			Name:          endpoint.Name,
+			Path:          endpoint.frontierPath(),
+			APIIdentifier: apiID,
+		}
+
+		if !exists {
+			if err := a.Create(ctx, &frontierEndpoint); err != nil {
+				return fmt.Errorf("failed creating endpoint %q: %w", endpoint.Path, err)
+			}
+		} else if current.endpoint.Name != endpoint.Name || current.endpoint.Path != endpoint.frontierPath() {
+			if err := a.Update(ctx, &frontierEndpoint); err != nil {
+				return fmt.Errorf("failed updating endpoint %q: %w", endpoint.Path, err)
+			}
+		}
+
+		currentActions := make(map[string]frontierv1.Action, len(current.actions))
+		for _, action := range current.actions {
+			method := strings.ToLower(action.HTTPRequestMethod)
+			if _, ok := currentActions[method]; ok {
+				obsolete = append(obsolete, frontierRemoteEndpoint{actions: []frontierv1.Action{action}})
+				continue
+			}
+			currentActions[method] = action
+		}
+
+		for _, action := range endpoint.Actions {
+			currentAction, exists := currentActions[action.Method]
+			delete(currentActions, action.Method)
+
+			frontierAction := action.frontierAction(currentAction.Identifier, frontierEndpoint.Identifier)
+
+			if !exists {
+				if err := a.Create(ctx, &frontierAction); err != nil {
+					return fmt.Errorf("failed creating action %q: %w", endpoint.actionKey(action), err)
+				}
+			} else if frontierOpenAPIActionFromAction(currentAction) != action {
+				if err := a.Update(ctx, &frontierAction); err != nil {
+					return fmt.Errorf("failed updating action %q: %w", endpoint.actionKey(action), err)
+				}
+			}
+		}
+
+		for _, action := range currentActions {
+			obsolete = append(obsolete, frontierRemoteEndpoint{actions: []frontierv1.Action{action}})
+		}
+	}
+
+	for _, r := range remoteByPath {
+		obsolete = append(obsolete, r)
+	}
+
+	return destroyFrontierEndpoints(ctx, a, obsolete)
+}
+
+// destroyFrontierEndpoints deletes the given actions and, if set, their endpoint afterwards
+func destroyFrontierEndpoints(ctx context.Context, a api.API, endpoints []frontierRemoteEndpoint) error {
+	for _, r := range endpoints {
+		for _, action := range r.actions {
+			if err := a.Destroy(ctx, &frontierv1.Action{Identifier: action.Identifier}); api.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed deleting action %q: %w", action.Identifier, err)
+			}
+		}
+
+		if r.endpoint.Identifier == "" {
+			continue
+		}
+
+		if err := a.Destroy(ctx, &frontierv1.Endpoint{Identifier: r.endpoint.Identifier}); api.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting endpoint %q: %w", r.endpoint.Identifier, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceFrontierOpenAPIRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	if err := a.Get(ctx, &frontierv1.API{Identifier: d.Id()}); api.IgnoreNotFound(err) != nil {
+		return diag.Errorf("failed getting API: %s", err)
+	} else if err != nil {
+		d.SetId("")
+		return nil
+	}
+
+	remote, err := listFrontierAPITree(ctx, a, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	endpointIDs := make(map[string]string, len(remote))
+	actionIDs := make(map[string]string)
+
+	for _, r := range remote {
+		endpoint := frontierOpenAPIEndpoint{Path: "/" + strings.TrimPrefix(r.endpoint.Path, "/")}
+		endpointIDs[endpoint.Path] = r.endpoint.Identifier
+
+		for _, action := range r.actions {
+			actionIDs[endpoint.actionKey(frontierOpenAPIActionFromAction(action))] = action.Identifier
+		}
+	}
+
+	var diags diag.Diagnostics
+
+	if err := d.Set("api", d.Id()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("endpoints", endpointIDs); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("actions", actionIDs); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("fingerprint", frontierOpenAPIFingerprint(frontierOpenAPIEndpointsFromRemote(remote))); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceFrontierOpenAPIDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	remote, err := listFrontierAPITree(ctx, a, d.Id())
+	if api.IgnoreNotFound(err) != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(destroyFrontierEndpoints(ctx, a, remote))
+}
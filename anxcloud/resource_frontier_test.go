@@ -51,6 +51,7 @@ func TestAccAnxCloudFrontier(t *testing.T) {
 		api = anxcloud_frontier_api.foo.id
 
 		revision = "%s"
+		retain_deployments = %d
 
 		depends_on = [
 			anxcloud_frontier_action.foo
@@ -64,15 +65,20 @@ func TestAccAnxCloudFrontier(t *testing.T) {
 
 	checkMockEndpoint := func(expectedResponse string) resource.TestCheckFunc {
 		return func(s *terraform.State) error {
-			api, ok := s.RootModule().Resources["anxcloud_frontier_api.foo"]
+			deployment, ok := s.RootModule().Resources["anxcloud_frontier_deployment.foo"]
 			if !ok {
-				return fmt.Errorf("anxcloud_frontier_api.foo not found in state")
+				return fmt.Errorf("anxcloud_frontier_deployment.foo not found in state")
+			}
+
+			url, ok := deployment.Primary.Attributes["endpoint_urls./bar/baz"]
+			if !ok {
+				return fmt.Errorf("url of endpoint /bar/baz not found in state")
 			}
 
 			// wait a few seconds for frontier to update
 			time.Sleep(20 * time.Second)
 
-			resp, err := http.Get(fmt.Sprintf("https://frontier.anexia-it.com/%s/foo/bar/baz", api.Primary.ID))
+			resp, err := http.Get(url)
 			if err != nil {
 				return fmt.Errorf("http: get mock frontier endpoint: %w", err)
 			}
@@ -100,7 +106,7 @@ func TestAccAnxCloudFrontier(t *testing.T) {
 					fmt.Sprintf(apiConfig, runName),
 					fmt.Sprintf(endpointConfig, runName),
 					fmt.Sprintf(actionConfig, "foo bar baz"),
-					fmt.Sprintf(deploymentConfig, "1"),
+					fmt.Sprintf(deploymentConfig, "1", 0),
 				}, "\n"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("anxcloud_frontier_deployment.foo", "state", "deployed"),
@@ -127,7 +133,7 @@ func TestAccAnxCloudFrontier(t *testing.T) {
 				ResourceName:            "anxcloud_frontier_deployment.foo",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"revision"},
+				ImportStateVerifyIgnore: []string{"revision", "retain_deployments", "previous_deployments"},
 			},
 			// test changes
 			{
@@ -135,13 +141,116 @@ func TestAccAnxCloudFrontier(t *testing.T) {
 					fmt.Sprintf(apiConfig, runName+"-changed"),
 					fmt.Sprintf(endpointConfig, runName+"-changed"),
 					fmt.Sprintf(actionConfig, "baz bar foo"),
-					fmt.Sprintf(deploymentConfig, "2"),
+					fmt.Sprintf(deploymentConfig, "2", 1),
 				}, "\n"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("anxcloud_frontier_deployment.foo", "state", "deployed"),
+					resource.TestCheckResourceAttr("anxcloud_frontier_deployment.foo", "previous_deployments.#", "1"),
 					checkMockEndpoint("baz bar foo"),
 				),
 			},
+			// changing an action without changing the revision is detected on the next refresh
+			{
+				Config: strings.Join([]string{
+					fmt.Sprintf(apiConfig, runName+"-changed"),
+					fmt.Sprintf(endpointConfig, runName+"-changed"),
+					fmt.Sprintf(actionConfig, "foo baz bar"),
+					fmt.Sprintf(deploymentConfig, "2", 1),
+				}, "\n"),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: strings.Join([]string{
+					fmt.Sprintf(apiConfig, runName+"-changed"),
+					fmt.Sprintf(endpointConfig, runName+"-changed"),
+					fmt.Sprintf(actionConfig, "foo baz bar"),
+					fmt.Sprintf(deploymentConfig, "2", 1),
+				}, "\n"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("anxcloud_frontier_deployment.foo", "fingerprint", "anxcloud_frontier_deployment.foo", "deployed_fingerprint"),
+					resource.TestCheckResourceAttr("anxcloud_frontier_deployment.foo", "previous_deployments.#", "1"),
+					checkMockEndpoint("foo baz bar"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAnxCloudFrontierOpenAPI(t *testing.T) {
+	environment.SkipIfNoEnvironment(t)
+
+	runName := environment.GetEnvInfo(t).TestRunName
+
+	config := `
+	resource "anxcloud_frontier_api" "foo" {
+		name = "terraform-test-openapi-%s"
+		transfer_protocol = "http"
+	}
+
+	resource "anxcloud_frontier_openapi" "foo" {
+		api  = anxcloud_frontier_api.foo.id
+		spec = <<-EOT
+			openapi: 3.0.3
+			info:
+			  title: terraform-test
+			  version: 1.0.0
+			paths:
+			%s
+		EOT
+	}
+	`
+
+	fooPath := `
+			  /foo:
+			    get:
+			      x-frontier-action:
+			        mock_response:
+			          body: foo
+			          language: plaintext
+	`
+
+	barPath := `
+			  /bar/{id}:
+			    summary: bar
+			    get:
+			      x-frontier-action:
+			        mock_response:
+			          body: bar
+			          language: plaintext
+			    delete:
+			      x-frontier-action:
+			        url_rewrite:
+			          url: https://example.com
+	`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, runName, strings.TrimSpace(fooPath)+"\n"+strings.TrimSpace(barPath)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anxcloud_frontier_openapi.foo", "endpoints.%", "2"),
+					resource.TestCheckResourceAttr("anxcloud_frontier_openapi.foo", "actions.%", "3"),
+					resource.TestCheckResourceAttrSet("anxcloud_frontier_openapi.foo", "actions.DELETE /bar/{id}"),
+					resource.TestCheckResourceAttrSet("anxcloud_frontier_openapi.foo", "fingerprint"),
+				),
+			},
+			{
+				ResourceName:            "anxcloud_frontier_openapi.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"spec"},
+			},
+			// removing a path deletes its endpoint and actions
+			{
+				Config: fmt.Sprintf(config, runName, strings.TrimSpace(fooPath)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anxcloud_frontier_openapi.foo", "endpoints.%", "1"),
+					resource.TestCheckResourceAttr("anxcloud_frontier_openapi.foo", "actions.%", "1"),
+					resource.TestCheckResourceAttrSet("anxcloud_frontier_openapi.foo", "actions.GET /foo"),
+				),
+			},
 		},
 	})
 }
@@ -208,11 +208,11 @@ func reserveAddress(ctx context.Context, pc providerContext, d *schema.ResourceD
 		reserveOpts.IPVersion = address.IPReserveVersionLimit(ipVersion.(int))
 	}
 
-	var successReserveSummary *address.ReserveRandomSummary
+	var successReservation *reservedAddress
 
 	return func() (any, string, error) {
-		if successReserveSummary == nil {
-			reserveSummary, err := addressClient.ReserveRandom(ctx, reserveOpts)
+		if successReservation == nil {
+			reserved, err := reserveRandomAddresses(ctx, pc, reserveOpts)
 
 			var respErr *client.ResponseError
 			if errors.As(err, &respErr) && respErr.ErrorData.Code == http.StatusConflict {
@@ -220,14 +220,12 @@ func reserveAddress(ctx context.Context, pc providerContext, d *schema.ResourceD
 				return nil, addressStateReserving, nil
 			} else if err != nil {
 				return nil, addressStateError, fmt.Errorf("reserve endpoint returned an error: %w", err)
-			} else if len(reserveSummary.Data) < 1 {
-				return nil, addressStateError, fmt.Errorf("reserve endpoint didn't return any addresses")
 			}
 
-			successReserveSummary = &reserveSummary
+			successReservation = &reserved[0]
 		}
 
-		return refreshAddressReadyState(ctx, addressClient, successReserveSummary.Data[0].ID, nil)
+		return refreshAddressReadyState(ctx, addressClient, successReservation.ID, nil)
 	}
 }
 
@@ -2,12 +2,16 @@ package anxcloud
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.anx.io/go-anxcloud/pkg/api/types"
 	"go.anx.io/go-anxcloud/pkg/ipam/prefix"
 )
 
@@ -33,10 +37,35 @@ func resourceNetworkPrefix() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: withTagsAttribute(schemaNetworkPrefix()),
+		Schema:        withTagsAttribute(schemaNetworkPrefix()),
+		CustomizeDiff: resourceNetworkPrefixCustomizeDiff,
 	}
 }
 
+// networkPrefixReplacementFields lists the attributes which can't be changed without replacing the prefix
+var networkPrefixReplacementFields = []string{"location_id", "netmask", "ip_version", "type"}
+
+// resourceNetworkPrefixCustomizeDiff refuses to replace an existing prefix, because all
+// addresses of the prefix would be lost, unless the replacement was explicitly allowed
+func resourceNetworkPrefixCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || d.Get("allow_replace").(bool) {
+		return nil
+	}
+
+	var changed []string
+	for _, field := range networkPrefixReplacementFields {
+		if d.HasChange(field) {
+			changed = append(changed, field)
+		}
+	}
+
+	if len(changed) > 0 {
+		return fmt.Errorf("changing %s requires replacing network prefix '%s', which releases all of its addresses. Set `allow_replace = true` to allow this", strings.Join(changed, ", "), d.Id())
+	}
+
+	return nil
+}
+
 func resourceNetworkPrefixCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(providerContext).legacyClient
 	p := prefix.NewAPI(c)
@@ -59,16 +88,7 @@ func resourceNetworkPrefixCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 	d.SetId(res.ID)
 
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
-		pref, err := p.Get(ctx, d.Id())
-		if err != nil {
-			return retry.NonRetryableError(fmt.Errorf("unable to get network prefix with '%s' id", d.Id()))
-		}
-		if pref.Status == prefixStatusActive || pref.Status == prefixStatusFailure {
-			return nil
-		}
-		return retry.RetryableError(fmt.Errorf("waiting for network prefix with '%s' id to be: %s", d.Id(), prefixStatusActive))
-	})
+	err = awaitNetworkPrefixStatus(ctx, p, d.Id(), d.Timeout(schema.TimeoutCreate), prefixStatusActive, prefixStatusFailure)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -95,6 +115,17 @@ func resourceNetworkPrefixRead(ctx context.Context, d *schema.ResourceData, m in
 	if err := d.Set("create_empty", createEmpty); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
+	allowReplace := d.Get("allow_replace")
+	if err := d.Set("allow_replace", allowReplace); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, networkPrefixIntoResourceData(info, d)...)
+}
+
+// networkPrefixIntoResourceData sets the attributes shared by the network prefix resource and data source
+func networkPrefixIntoResourceData(info prefix.Info, d *schema.ResourceData) diag.Diagnostics {
+	var diags []diag.Diagnostic
 
 	// CIDR value is set in the 'name' field, this should be changed
 	if err := d.Set("cidr", info.Name); err != nil {
@@ -133,9 +164,12 @@ func resourceNetworkPrefixRead(ctx context.Context, d *schema.ResourceData, m in
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	if len(info.Vlans) == 0 {
-		diags = append(diags, diag.Errorf("no VLAN seems to be attached to prefix '%s'", info.ID)...)
-	} else if err := d.Set("vlan_id", info.Vlans[0].ID); err != nil {
+	// prefixes can be unassigned from their VLAN
+	vlanID := ""
+	if len(info.Vlans) > 0 {
+		vlanID = info.Vlans[0].ID
+	}
+	if err := d.Set("vlan_id", vlanID); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
@@ -146,20 +180,54 @@ func resourceNetworkPrefixUpdate(ctx context.Context, d *schema.ResourceData, m
 	c := m.(providerContext).legacyClient
 	p := prefix.NewAPI(c)
 
-	if !d.HasChange("description_customer") {
+	if !d.HasChanges("description_customer", "router_redundancy", "vlan_id", "organization") {
 		return nil
 	}
 
-	def := prefix.Update{
+	update := &networkPrefixUpdate{
+		prefixID:            d.Id(),
 		CustomerDescription: d.Get("description_customer").(string),
+		RouterRedundancy:    d.Get("router_redundancy").(bool),
+		Organization:        d.Get("organization").(string),
 	}
-	if _, err := p.Update(ctx, d.Id(), def); err != nil {
+
+	// an empty vlan_id unassigns the prefix from its VLAN
+	if vlanID := d.Get("vlan_id").(string); vlanID != "" {
+		update.VLANID = &vlanID
+	}
+
+	if err := apiFromProviderConfig(m).Update(ctx, update); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err := awaitNetworkPrefixStatus(ctx, p, d.Id(), d.Timeout(schema.TimeoutUpdate), prefixStatusActive)
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	return resourceNetworkPrefixRead(ctx, d, m)
 }
 
+// awaitNetworkPrefixStatus polls the prefix until it reached one of the given statuses.
+// A prefix in status Failed is reported as an error, unless prefixStatusFailure is one of the expected statuses.
+func awaitNetworkPrefixStatus(ctx context.Context, p prefix.API, id string, timeout time.Duration, expectedStatus ...string) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		pref, err := p.Get(ctx, id)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("unable to get network prefix with '%s' id", id))
+		}
+		for _, status := range expectedStatus {
+			if pref.Status == status {
+				return nil
+			}
+		}
+		if pref.Status == prefixStatusFailure {
+			return retry.NonRetryableError(fmt.Errorf("network prefix with '%s' id is in status: %s", id, prefixStatusFailure))
+		}
+		return retry.RetryableError(fmt.Errorf("waiting for network prefix with '%s' id to be: %s", id, strings.Join(expectedStatus, ", ")))
+	})
+}
+
 func resourceNetworkPrefixDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(providerContext).legacyClient
 	p := prefix.NewAPI(c)
@@ -193,3 +261,29 @@ func resourceNetworkPrefixDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return nil
 }
+
+// todo: move to go-anxcloud at a later time, prefix.Update only supports the customer description
+type networkPrefixUpdate struct {
+	prefixID            string
+	CustomerDescription string  `json:"description_customer"`
+	RouterRedundancy    bool    `json:"router_redundancy"`
+	Organization        string  `json:"organization,omitempty"`
+	VLANID              *string `json:"vlan"`
+}
+
+func (u *networkPrefixUpdate) GetIdentifier(ctx context.Context) (string, error) {
+	return u.prefixID, nil
+}
+
+func (u *networkPrefixUpdate) EndpointURL(ctx context.Context) (*url.URL, error) {
+	op, err := types.OperationFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != types.OperationUpdate {
+		return nil, errors.New("helper resource 'networkPrefixUpdate' only supports Update operations")
+	}
+
+	return url.Parse("/api/ipam/v1/prefix.json/")
+}
@@ -3,6 +3,7 @@ package anxcloud
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/testutils/environment"
@@ -94,6 +95,66 @@ func TestAccAnxCloudNetworkPrefixTags(t *testing.T) {
 	})
 }
 
+func TestAccAnxCloudNetworkPrefixInPlaceUpdate(t *testing.T) {
+	environment.SkipIfNoEnvironment(t)
+	envInfo := environment.GetEnvInfo(t)
+
+	resourcePath := "anxcloud_network_prefix.foo"
+
+	tpl := func(netmask int, routerRedundancy, allowReplace bool) string {
+		return fmt.Sprintf(`
+		resource "anxcloud_network_prefix" "foo" {
+			vlan_id              = "%s"
+			location_id          = "%s"
+			ip_version           = 4
+			type                 = 1
+			netmask              = %d
+			router_redundancy    = %t
+			allow_replace        = %t
+			description_customer = "tf-acc-in-place-update"
+		}`, envInfo.VlanID, envInfo.Location, netmask, routerRedundancy, allowReplace)
+	}
+
+	var prefixID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAnxCloudNetworkPrefixDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tpl(29, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourcePath, "router_redundancy", "false"),
+					func(s *terraform.State) error {
+						prefixID = s.RootModule().Resources[resourcePath].Primary.ID
+						return nil
+					},
+				),
+			},
+			// router redundancy can be toggled without replacing the prefix
+			{
+				Config: tpl(29, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourcePath, "router_redundancy", "true"),
+					resource.TestCheckResourceAttrPtr(resourcePath, "id", &prefixID),
+				),
+			},
+			// replacement is refused unless explicitly allowed
+			{
+				Config:      tpl(28, true, false),
+				ExpectError: regexp.MustCompile("requires replacing network prefix"),
+			},
+			{
+				Config: tpl(28, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourcePath, "netmask", "28"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAnxCloudNetworkPrefixDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(providerContext).legacyClient
 	p := prefix.NewAPI(c)
@@ -15,13 +15,13 @@ import (
 
 func resourceObjectStorageBackend() *schema.Resource {
 	return &schema.Resource{
-		Description:   "This resource allows you to create and manage Object Storage S3 backends.",
-		CreateContext: resourceObjectStorageBackendCreate,
-		ReadContext:   resourceObjectStorageBackendRead,
-		UpdateContext: resourceObjectStorageBackendUpdate,
+		Description:   "This resource allows you to create and manage Object Storage S3 backends. Backends are imported with an ID of the form `<customer>/<identifier>`.",
+		CreateContext: tagsMiddlewareCreate(resourceObjectStorageBackendCreate),
+		ReadContext:   tagsMiddlewareRead(resourceObjectStorageBackendRead),
+		UpdateContext: tagsMiddlewareUpdate(resourceObjectStorageBackendUpdate),
 		DeleteContext: resourceObjectStorageBackendDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateObjectStorage(false),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
@@ -29,7 +29,7 @@ func resourceObjectStorageBackend() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Schema: schemaObjectStorageBackend(),
+		Schema: withTagsAttribute(schemaObjectStorageBackend()),
 	}
 }
 
@@ -102,6 +102,11 @@ func resourceObjectStorageBackendCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	d.SetId(backend.Identifier)
+
+	if diags := waitForObjectStorageState(ctx, a, &objectstoragev2.S3Backend{Identifier: backend.Identifier}, "backend", d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		return diags
+	}
+
 	return resourceObjectStorageBackendRead(ctx, d, m)
 }
 
@@ -175,6 +180,10 @@ func resourceObjectStorageBackendUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	if diags := waitForObjectStorageState(ctx, a, &objectstoragev2.S3Backend{Identifier: d.Id()}, "backend", d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+		return diags
+	}
+
 	return resourceObjectStorageBackendRead(ctx, d, m)
 }
 
@@ -3,12 +3,15 @@ package anxcloud
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"go.anx.io/go-anxcloud/pkg/api"
 	"go.anx.io/go-anxcloud/pkg/apis/common"
@@ -17,13 +20,13 @@ import (
 
 func resourceObjectStorageBucket() *schema.Resource {
 	return &schema.Resource{
-		Description:   "This resource allows you to create and manage Object Storage buckets.",
-		CreateContext: resourceObjectStorageBucketCreate,
-		ReadContext:   resourceObjectStorageBucketRead,
-		UpdateContext: resourceObjectStorageBucketUpdate,
+		Description:   "This resource allows you to create and manage Object Storage buckets. Buckets are imported with an ID of the form `<customer>/<backend>/<region>/<identifier>`.",
+		CreateContext: tagsMiddlewareCreate(resourceObjectStorageBucketCreate),
+		ReadContext:   tagsMiddlewareRead(resourceObjectStorageBucketRead),
+		UpdateContext: tagsMiddlewareUpdate(resourceObjectStorageBucketUpdate),
 		DeleteContext: resourceObjectStorageBucketDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceObjectStorageBucketImport,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
@@ -31,7 +34,7 @@ func resourceObjectStorageBucket() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Schema: schemaObjectStorageBucket(),
+		Schema: withTagsAttribute(schemaObjectStorageBucket()),
 	}
 }
 
@@ -81,6 +84,138 @@ func schemaObjectStorageBucket() map[string]*schema.Schema {
 				Default:     false,
 				Description: "When true, the bucket will be forcibly deleted along with all its contents using the EmptyAndDelete method. When false (default), only empty buckets can be deleted. This must be explicitly set to true to delete non-empty buckets.",
 			},
+			"s3": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Access to the S3 API of the bucket, required to manage `lifecycle_rule`, `cors_rule` and `policy`. The credentials can be taken from an `anxcloud_object_storage_key` of the bucket's tenant.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							Description:  "URL of the S3 endpoint, buckets are addressed path-style below it.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "us-east-1",
+							Description: "Region used to sign requests. Defaults to `us-east-1`.",
+						},
+						"access_key_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "S3 access key ID.",
+						},
+						"secret_access_key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "S3 secret access key.",
+						},
+					},
+				},
+			},
+			"lifecycle_rule": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				RequiredWith: []string{"s3"},
+				Description:  "Lifecycle rules of the bucket. Each rule needs at least one of `expiration_days`, `noncurrent_version_expiration_days` and `abort_incomplete_multipart_upload_days`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Unique identifier of the rule.",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the rule is applied. Defaults to true.",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Object key prefix the rule applies to. Applies to all objects if unset.",
+						},
+						"expiration_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Number of days after creation when objects expire.",
+						},
+						"noncurrent_version_expiration_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Number of days after becoming noncurrent when object versions are deleted.",
+						},
+						"abort_incomplete_multipart_upload_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Number of days after initiation when incomplete multipart uploads are aborted.",
+						},
+					},
+				},
+			},
+			"cors_rule": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				RequiredWith: []string{"s3"},
+				Description:  "CORS rules of the bucket.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Unique identifier of the rule.",
+						},
+						"allowed_methods": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"GET", "PUT", "POST", "DELETE", "HEAD"}, false),
+							},
+							Description: "HTTP methods allowed for cross-origin requests.",
+						},
+						"allowed_origins": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Origins allowed to make cross-origin requests.",
+						},
+						"allowed_headers": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Headers allowed in preflight requests.",
+						},
+						"expose_headers": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Response headers accessible by clients.",
+						},
+						"max_age_seconds": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Time in seconds browsers may cache preflight responses.",
+						},
+					},
+				},
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				RequiredWith:     []string{"s3"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "Bucket policy as JSON document.",
+			},
 		},
 	)
 }
@@ -118,9 +253,53 @@ func resourceObjectStorageBucketCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	d.SetId(bucket.Identifier)
+
+	if diags := waitForObjectStorageState(ctx, a, &objectstoragev2.Bucket{Identifier: bucket.Identifier}, "bucket", d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		return diags
+	}
+
+	bucketName := bucket.Name
+	if bucketName == "" {
+		bucketName = d.Get("name").(string)
+	}
+
+	// the bucket might not be available on the S3 endpoint right after it was created
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		err := updateObjectStorageBucketS3Configuration(ctx, d, bucketName, true)
+		if isS3ErrorCode(err, "NoSuchBucket") {
+			return retry.RetryableError(err)
+		} else if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceObjectStorageBucketRead(ctx, d, m)
 }
 
+// resourceObjectStorageBucketImport sets the write once references from the import ID and
+// the name from the API, as it isn't set by read
+func resourceObjectStorageBucketImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	result, err := importStateObjectStorage(false, "backend", "region")(ctx, d, m)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := objectstoragev2.Bucket{Identifier: d.Id()}
+	if err := apiFromProviderConfig(m).Get(ctx, &bucket); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("name", bucket.Name); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func resourceObjectStorageBucketRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	a := apiFromProviderConfig(m)
@@ -166,6 +345,8 @@ func resourceObjectStorageBucketRead(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	diags = append(diags, readObjectStorageBucketS3Configuration(ctx, d, bucket.Name)...)
+
 	// Set common fields
 	setObjectStorageCommonFieldsFromAPI(&bucket, d, &diags)
 	setObjectStorageStateFieldFromAPI(&bucket, d, &diags)
@@ -202,7 +383,18 @@ func resourceObjectStorageBucketUpdate(ctx context.Context, d *schema.ResourceDa
 	setObjectStorageCommonFields(&bucket, d)
 	setObjectStorageStateField(&bucket, d)
 
-	if err := a.Update(ctx, &bucket); err != nil {
+	// tags are managed by the tags middleware
+	if d.HasChangesExcept(append(objectStorageBucketS3Attributes, "tags")...) {
+		if err := a.Update(ctx, &bucket); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if diags := waitForObjectStorageState(ctx, a, &objectstoragev2.Bucket{Identifier: d.Id()}, "bucket", d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	if err := updateObjectStorageBucketS3Configuration(ctx, d, d.Get("actual_name").(string), false); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -263,3 +455,209 @@ func resourceObjectStorageBucketDelete(ctx context.Context, d *schema.ResourceDa
 
 	return nil
 }
+
+// objectStorageBucketS3Attributes are the attributes of a bucket managed via the S3 API
+var objectStorageBucketS3Attributes = []string{"s3", "lifecycle_rule", "cors_rule", "policy"}
+
+// objectStorageBucketS3Client returns a client for the S3 API configured in the s3 block, nil if there is none
+func objectStorageBucketS3Client(d *schema.ResourceData) (*s3Client, error) {
+	s3 := d.Get("s3").([]interface{})
+	if len(s3) == 0 || s3[0] == nil {
+		return nil, nil
+	}
+
+	config := s3[0].(map[string]interface{})
+	return newS3Client(
+		config["endpoint"].(string),
+		config["region"].(string),
+		config["access_key_id"].(string),
+		config["secret_access_key"].(string),
+	)
+}
+
+// updateObjectStorageBucketS3Configuration applies lifecycle rules, CORS rules and policy of a bucket.
+// On create only configured settings are applied, otherwise only changed ones.
+func updateObjectStorageBucketS3Configuration(ctx context.Context, d *schema.ResourceData, bucketName string, create bool) error {
+	client, err := objectStorageBucketS3Client(d)
+	if err != nil || client == nil {
+		return err
+	}
+
+	lifecycleRules, err := expandObjectStorageBucketLifecycleRules(d.Get("lifecycle_rule").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if create && len(lifecycleRules) > 0 || !create && d.HasChange("lifecycle_rule") {
+		if err := client.putBucketLifecycle(ctx, bucketName, lifecycleRules); err != nil {
+			return fmt.Errorf("failed configuring lifecycle rules: %w", err)
+		}
+	}
+
+	corsRules := expandObjectStorageBucketCORSRules(d.Get("cors_rule").([]interface{}))
+	if create && len(corsRules) > 0 || !create && d.HasChange("cors_rule") {
+		if err := client.putBucketCORS(ctx, bucketName, corsRules); err != nil {
+			return fmt.Errorf("failed configuring CORS rules: %w", err)
+		}
+	}
+
+	policy := d.Get("policy").(string)
+	if create && policy != "" || !create && d.HasChange("policy") {
+		if err := client.putBucketPolicy(ctx, bucketName, policy); err != nil {
+			return fmt.Errorf("failed configuring policy: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// readObjectStorageBucketS3Configuration reads lifecycle rules, CORS rules and policy of a bucket, if the s3 block is configured
+func readObjectStorageBucketS3Configuration(ctx context.Context, d *schema.ResourceData, bucketName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, err := objectStorageBucketS3Client(d)
+	if err != nil {
+		return diag.FromErr(err)
+	} else if client == nil {
+		return nil
+	}
+
+	if rules, err := client.getBucketLifecycle(ctx, bucketName); err != nil {
+		diags = append(diags, diag.Errorf("failed reading lifecycle rules: %s", err)...)
+	} else if err := d.Set("lifecycle_rule", flattenObjectStorageBucketLifecycleRules(rules)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if rules, err := client.getBucketCORS(ctx, bucketName); err != nil {
+		diags = append(diags, diag.Errorf("failed reading CORS rules: %s", err)...)
+	} else if err := d.Set("cors_rule", flattenObjectStorageBucketCORSRules(rules)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if policy, err := client.getBucketPolicy(ctx, bucketName); err != nil {
+		diags = append(diags, diag.Errorf("failed reading policy: %s", err)...)
+	} else if err := d.Set("policy", policy); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func expandObjectStorageBucketLifecycleRules(in []interface{}) ([]s3LifecycleRule, error) {
+	rules := make([]s3LifecycleRule, 0, len(in))
+
+	for _, r := range in {
+		rule := r.(map[string]interface{})
+
+		lifecycleRule := s3LifecycleRule{
+			ID:     rule["id"].(string),
+			Filter: s3LifecycleFilter{Prefix: rule["prefix"].(string)},
+			Status: "Enabled",
+		}
+
+		if !rule["enabled"].(bool) {
+			lifecycleRule.Status = "Disabled"
+		}
+
+		if days := rule["expiration_days"].(int); days > 0 {
+			lifecycleRule.Expiration = &s3LifecycleExpiration{Days: days}
+		}
+
+		if days := rule["noncurrent_version_expiration_days"].(int); days > 0 {
+			lifecycleRule.NoncurrentVersionExpiration = &s3NoncurrentVersionExpiration{NoncurrentDays: days}
+		}
+
+		if days := rule["abort_incomplete_multipart_upload_days"].(int); days > 0 {
+			lifecycleRule.AbortIncompleteMultipartUpload = &s3AbortIncompleteMultipartUpload{DaysAfterInitiation: days}
+		}
+
+		if lifecycleRule.Expiration == nil && lifecycleRule.NoncurrentVersionExpiration == nil && lifecycleRule.AbortIncompleteMultipartUpload == nil {
+			return nil, fmt.Errorf("lifecycle rule %q has no action, configure at least one of expiration_days, noncurrent_version_expiration_days and abort_incomplete_multipart_upload_days", lifecycleRule.ID)
+		}
+
+		rules = append(rules, lifecycleRule)
+	}
+
+	return rules, nil
+}
+
+func flattenObjectStorageBucketLifecycleRules(rules []s3LifecycleRule) []interface{} {
+	out := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		prefix := rule.Filter.Prefix
+		if prefix == "" {
+			prefix = rule.Prefix
+		}
+
+		flattened := map[string]interface{}{
+			"id":                                     rule.ID,
+			"enabled":                                rule.Status == "Enabled",
+			"prefix":                                 prefix,
+			"expiration_days":                        0,
+			"noncurrent_version_expiration_days":     0,
+			"abort_incomplete_multipart_upload_days": 0,
+		}
+
+		if rule.Expiration != nil {
+			flattened["expiration_days"] = rule.Expiration.Days
+		}
+
+		if rule.NoncurrentVersionExpiration != nil {
+			flattened["noncurrent_version_expiration_days"] = rule.NoncurrentVersionExpiration.NoncurrentDays
+		}
+
+		if rule.AbortIncompleteMultipartUpload != nil {
+			flattened["abort_incomplete_multipart_upload_days"] = rule.AbortIncompleteMultipartUpload.DaysAfterInitiation
+		}
+
+		out = append(out, flattened)
+	}
+
+	return out
+}
+
+func expandObjectStorageBucketCORSRules(in []interface{}) []s3CORSRule {
+	rules := make([]s3CORSRule, 0, len(in))
+
+	for _, r := range in {
+		rule := r.(map[string]interface{})
+
+		rules = append(rules, s3CORSRule{
+			ID:             rule["id"].(string),
+			AllowedHeaders: expandSortedStringSet(rule["allowed_headers"].(*schema.Set)),
+			AllowedMethods: expandSortedStringSet(rule["allowed_methods"].(*schema.Set)),
+			AllowedOrigins: expandSortedStringSet(rule["allowed_origins"].(*schema.Set)),
+			ExposeHeaders:  expandSortedStringSet(rule["expose_headers"].(*schema.Set)),
+			MaxAgeSeconds:  rule["max_age_seconds"].(int),
+		})
+	}
+
+	return rules
+}
+
+func flattenObjectStorageBucketCORSRules(rules []s3CORSRule) []interface{} {
+	out := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		out = append(out, map[string]interface{}{
+			"id":              rule.ID,
+			"allowed_headers": rule.AllowedHeaders,
+			"allowed_methods": rule.AllowedMethods,
+			"allowed_origins": rule.AllowedOrigins,
+			"expose_headers":  rule.ExposeHeaders,
+			"max_age_seconds": rule.MaxAgeSeconds,
+		})
+	}
+
+	return out
+}
+
+func expandSortedStringSet(set *schema.Set) []string {
+	out := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		out = append(out, v.(string))
+	}
+	sort.Strings(out)
+	return out
+}
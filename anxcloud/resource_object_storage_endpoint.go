@@ -13,13 +13,13 @@ import (
 
 func resourceObjectStorageEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Description:   "This resource allows you to create and manage Object Storage endpoints.",
-		CreateContext: resourceObjectStorageEndpointCreate,
-		ReadContext:   resourceObjectStorageEndpointRead,
-		UpdateContext: resourceObjectStorageEndpointUpdate,
+		Description:   "This resource allows you to create and manage Object Storage endpoints. Endpoints are imported with an ID of the form `<customer>/<identifier>`.",
+		CreateContext: tagsMiddlewareCreate(resourceObjectStorageEndpointCreate),
+		ReadContext:   tagsMiddlewareRead(resourceObjectStorageEndpointRead),
+		UpdateContext: tagsMiddlewareUpdate(resourceObjectStorageEndpointUpdate),
 		DeleteContext: resourceObjectStorageEndpointDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateObjectStorage(false),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
@@ -27,7 +27,7 @@ func resourceObjectStorageEndpoint() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Schema: schemaObjectStorageEndpoint(),
+		Schema: withTagsAttribute(schemaObjectStorageEndpoint()),
 	}
 }
 
@@ -81,6 +81,11 @@ func resourceObjectStorageEndpointCreate(ctx context.Context, d *schema.Resource
 	}
 
 	d.SetId(endpoint.Identifier)
+
+	if diags := waitForObjectStorageState(ctx, a, &objectstoragev2.Endpoint{Identifier: endpoint.Identifier}, "endpoint", d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		return diags
+	}
+
 	return resourceObjectStorageEndpointRead(ctx, d, m)
 }
 
@@ -135,6 +140,10 @@ func resourceObjectStorageEndpointUpdate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
+	if diags := waitForObjectStorageState(ctx, a, &objectstoragev2.Endpoint{Identifier: d.Id()}, "endpoint", d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+		return diags
+	}
+
 	return resourceObjectStorageEndpointRead(ctx, d, m)
 }
 
@@ -2,6 +2,7 @@ package anxcloud
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,18 +13,22 @@ import (
 
 func resourceObjectStorageKey() *schema.Resource {
 	return &schema.Resource{
-		Description:   "This resource allows you to configure Object Storage keys.",
-		CreateContext: resourceObjectStorageKeyCreate,
-		ReadContext:   resourceObjectStorageKeyRead,
-		UpdateContext: resourceObjectStorageKeyUpdate,
+		Description:   "This resource allows you to configure Object Storage keys. Keys are imported with an ID of the form `<customer>/<backend>/<identifier>` or just `<customer>/<identifier>` if it has no backend.",
+		CreateContext: tagsMiddlewareCreate(resourceObjectStorageKeyCreate),
+		ReadContext:   tagsMiddlewareRead(resourceObjectStorageKeyRead),
+		UpdateContext: tagsMiddlewareUpdate(resourceObjectStorageKeyUpdate),
 		DeleteContext: resourceObjectStorageKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateObjectStorage(true, "backend"),
+		},
+		CustomizeDiff: resourceObjectStorageKeyCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Read:   schema.DefaultTimeout(1 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
-		Schema: mergeSchemas(
+		Schema: withTagsAttribute(mergeSchemas(
 			schemaObjectStorageCommon(),
 			schemaObjectStorageState(),
 			map[string]*schema.Schema{
@@ -48,9 +53,46 @@ func resourceObjectStorageKey() *schema.Resource {
 					Description: "Identifier of the user this key belongs to.",
 				},
 				"expire_date": {
-					Type:        schema.TypeString,
+					Type:          schema.TypeString,
+					Optional:      true,
+					Computed:      true,
+					ConflictsWith: []string{"rotation"},
+					Description:   "Expiration date for the key in RFC3339 format. Managed by the provider if `rotation` is configured.",
+				},
+				"rotation": {
+					Type:        schema.TypeList,
 					Optional:    true,
-					Description: "Expiration date for the key in RFC3339 format.",
+					MaxItems:    1,
+					Description: "Rotates the key before it expires. A new key is created and the previous one expires after a grace period.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"validity": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateDuration,
+								Description:  "Lifetime of the keys created by this resource as a duration, e.g. `2160h`. Sets `expire_date` of every new key.",
+							},
+							"rotate_before": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "168h",
+								ValidateFunc: validateDuration,
+								Description:  "The key is rotated on the first apply within this duration before `expire_date`. Defaults to `168h`.",
+							},
+							"grace_period": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "1h",
+								ValidateFunc: validateDuration,
+								Description:  "Duration the previous key stays valid after a rotation. Defaults to `1h`.",
+							},
+						},
+					},
+				},
+				"previous_key": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Identifier of the key replaced by the last rotation. It is deleted by the next rotation or when this resource is destroyed.",
 				},
 				"remote_id": {
 					Type:        schema.TypeString,
@@ -63,13 +105,75 @@ func resourceObjectStorageKey() *schema.Resource {
 					Sensitive:   true,
 					Description: "URL containing the secret key.",
 				},
+				"access_key_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "S3 access key ID, resolved from `secret_url`.",
+				},
+				"secret_access_key": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Sensitive:   true,
+					Description: "S3 secret access key, resolved from `secret_url`.",
+				},
 			},
-		),
+		)),
 	}
 }
 
-func resourceObjectStorageKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	a := apiFromProviderConfig(m)
+type objectStorageKeyRotation struct {
+	validity     time.Duration
+	rotateBefore time.Duration
+	gracePeriod  time.Duration
+}
+
+func expandObjectStorageKeyRotation(in []any) (objectStorageKeyRotation, bool) {
+	if len(in) == 0 || in[0] == nil {
+		return objectStorageKeyRotation{}, false
+	}
+
+	rotation := in[0].(map[string]any)
+
+	// durations are checked by the schema validation
+	validity, _ := time.ParseDuration(rotation["validity"].(string))
+	rotateBefore, _ := time.ParseDuration(rotation["rotate_before"].(string))
+	gracePeriod, _ := time.ParseDuration(rotation["grace_period"].(string))
+
+	return objectStorageKeyRotation{
+		validity:     validity,
+		rotateBefore: rotateBefore,
+		gracePeriod:  gracePeriod,
+	}, true
+}
+
+func resourceObjectStorageKeyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	rotation, ok := expandObjectStorageKeyRotation(d.Get("rotation").([]any))
+	if !ok || d.Id() == "" {
+		return nil
+	}
+
+	expireDate := d.Get("expire_date").(string)
+	if expireDate == "" {
+		// rotation was enabled for a key without expiration date, it will be set on update
+		return d.SetNewComputed("expire_date")
+	}
+
+	due, err := objectStorageKeyRotationDue(expireDate, rotation.rotateBefore, time.Now())
+	if err != nil || !due {
+		return err
+	}
+
+	for _, key := range []string{"expire_date", "remote_id", "secret_url", "access_key_id", "secret_access_key", "previous_key"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// objectStorageKeyFromResourceData builds a new key from the configuration
+func objectStorageKeyFromResourceData(d *schema.ResourceData) (objectstoragev2.Key, error) {
 	key := objectstoragev2.Key{
 		Name: d.Get("name").(string),
 	}
@@ -92,10 +196,13 @@ func resourceObjectStorageKeyCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	if v, ok := d.GetOk("expire_date"); ok {
+	if rotation, ok := expandObjectStorageKeyRotation(d.Get("rotation").([]any)); ok {
+		expireTime := time.Now().Add(rotation.validity).UTC().Truncate(time.Second)
+		key.ExpireDate = &expireTime
+	} else if v, ok := d.GetOk("expire_date"); ok {
 		expireTime, err := time.Parse(time.RFC3339, v.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return key, err
 		}
 		key.ExpireDate = &expireTime
 	}
@@ -103,18 +210,82 @@ func resourceObjectStorageKeyCreate(ctx context.Context, d *schema.ResourceData,
 	setObjectStorageCommonFields(&key, d)
 	setObjectStorageStateField(&key, d)
 
-	err := a.Create(ctx, &key)
+	return key, nil
+}
+
+// resolveObjectStorageKeyCredentials returns access key ID and secret access key of a key by
+// resolving its secret URL. The remote identifier is used if the secret has no access key ID.
+func resolveObjectStorageKeyCredentials(ctx context.Context, pc providerContext, key *objectstoragev2.Key) (string, string, error) {
+	if key.SecretURL == "" {
+		if err := pc.api.Get(ctx, key); err != nil {
+			return "", "", err
+		}
+	}
+
+	if key.SecretURL == "" {
+		return "", "", fmt.Errorf("key has no secret URL")
+	}
+
+	document, err := fetchObjectStorageKeySecret(ctx, pc.legacyClient, key.SecretURL)
+	if err != nil {
+		return "", "", fmt.Errorf("failed retrieving secret: %w", err)
+	}
+
+	accessKeyID, secretAccessKey, err := parseObjectStorageKeySecret(document)
+	if err != nil {
+		return "", "", err
+	}
+
+	if accessKeyID == "" && key.RemoteID != nil {
+		accessKeyID = *key.RemoteID
+	}
+
+	return accessKeyID, secretAccessKey, nil
+}
+
+func setObjectStorageKeyCredentials(d *schema.ResourceData, secretURL, accessKeyID, secretAccessKey string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := d.Set("secret_url", secretURL); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("access_key_id", accessKeyID); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("secret_access_key", secretAccessKey); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceObjectStorageKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	pc := m.(providerContext)
+
+	key, err := objectStorageKeyFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = pc.api.Create(ctx, &key)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(key.Identifier)
+
+	if diags := waitForObjectStorageState(ctx, pc.api, &objectstoragev2.Key{Identifier: key.Identifier}, "key", d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		return diags
+	}
+
+	// S3 credentials are resolved on a best effort basis by Read
 	return resourceObjectStorageKeyRead(ctx, d, m)
 }
 
 func resourceObjectStorageKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	a := apiFromProviderConfig(m)
+	pc := m.(providerContext)
+	a := pc.api
 	key := objectstoragev2.Key{Identifier: d.Id()}
 
 	err := a.Get(ctx, &key)
@@ -157,8 +328,19 @@ func resourceObjectStorageKeyRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	if err := d.Set("secret_url", key.SecretURL); err != nil {
-		diags = append(diags, diag.FromErr(err)...)
+	// the secret is only resolved again if it changed, as secret URLs might not be retrievable repeatedly
+	if key.SecretURL != d.Get("secret_url").(string) || d.Get("secret_access_key").(string) == "" {
+		accessKeyID, secretAccessKey, err := resolveObjectStorageKeyCredentials(ctx, pc, &key)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unable to resolve S3 credentials",
+				Detail:   fmt.Sprintf("The secret URL of the key couldn't be resolved, access_key_id and secret_access_key are not available: %s", err),
+			})
+			accessKeyID, secretAccessKey = "", ""
+		}
+
+		diags = append(diags, setObjectStorageKeyCredentials(d, key.SecretURL, accessKeyID, secretAccessKey)...)
 	}
 
 	setObjectStorageCommonFieldsFromAPI(&key, d, &diags)
@@ -169,6 +351,20 @@ func resourceObjectStorageKeyRead(ctx context.Context, d *schema.ResourceData, m
 
 func resourceObjectStorageKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	a := apiFromProviderConfig(m)
+
+	rotation, rotationEnabled := expandObjectStorageKeyRotation(d.Get("rotation").([]any))
+	if rotationEnabled {
+		oldExpireDate, _ := d.GetChange("expire_date")
+		due, err := objectStorageKeyRotationDue(oldExpireDate.(string), rotation.rotateBefore, time.Now())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if due {
+			return resourceObjectStorageKeyRotate(ctx, d, m, rotation)
+		}
+	}
+
 	key := objectstoragev2.Key{Identifier: d.Id()}
 
 	err := a.Get(ctx, &key)
@@ -180,7 +376,12 @@ func resourceObjectStorageKeyUpdate(ctx context.Context, d *schema.ResourceData,
 		key.Name = d.Get("name").(string)
 	}
 
-	if d.HasChange("expire_date") {
+	if rotationEnabled {
+		if key.ExpireDate == nil {
+			expireTime := time.Now().Add(rotation.validity).UTC().Truncate(time.Second)
+			key.ExpireDate = &expireTime
+		}
+	} else if d.HasChange("expire_date") {
 		if v, ok := d.GetOk("expire_date"); ok {
 			expireTime, err := time.Parse(time.RFC3339, v.(string))
 			if err != nil {
@@ -200,17 +401,93 @@ func resourceObjectStorageKeyUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	if diags := waitForObjectStorageState(ctx, a, &objectstoragev2.Key{Identifier: d.Id()}, "key", d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+		return diags
+	}
+
+	return resourceObjectStorageKeyRead(ctx, d, m)
+}
+
+// resourceObjectStorageKeyRotate creates a new key and lets the current one expire after the grace period.
+// The key replaced by the previous rotation is deleted first, the new key is deleted again if the rotation fails.
+func resourceObjectStorageKeyRotate(ctx context.Context, d *schema.ResourceData, m interface{}, rotation objectStorageKeyRotation) diag.Diagnostics {
+	pc := m.(providerContext)
+	a := pc.api
+
+	if previousKey, _ := d.GetChange("previous_key"); previousKey.(string) != "" {
+		if err := a.Destroy(ctx, &objectstoragev2.Key{Identifier: previousKey.(string)}); err != nil {
+			if err := handleNotFoundError(err); err != nil {
+				return diag.Errorf("failed deleting previous key: %s", err)
+			}
+		}
+
+		if err := d.Set("previous_key", ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	key, err := objectStorageKeyFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := a.Create(ctx, &key); err != nil {
+		return diag.Errorf("failed creating rotated key: %s", err)
+	}
+
+	// the current key stays in use, the new one is removed on a best effort basis
+	abort := func(diags diag.Diagnostics) diag.Diagnostics {
+		_ = a.Destroy(ctx, &objectstoragev2.Key{Identifier: key.Identifier})
+		return diags
+	}
+
+	if diags := waitForObjectStorageState(ctx, a, &objectstoragev2.Key{Identifier: key.Identifier}, "rotated key", d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+		return abort(diags)
+	}
+
+	accessKeyID, secretAccessKey, err := resolveObjectStorageKeyCredentials(ctx, pc, &key)
+	if err != nil {
+		return abort(diag.Errorf("failed resolving S3 credentials of rotated key: %s", err))
+	}
+
+	currentKey := objectstoragev2.Key{Identifier: d.Id()}
+	if err := a.Get(ctx, &currentKey); err != nil {
+		return abort(diag.FromErr(err))
+	}
+
+	graceEnd := time.Now().Add(rotation.gracePeriod).UTC().Truncate(time.Second)
+	currentKey.ExpireDate = &graceEnd
+
+	if err := a.Update(ctx, &currentKey); err != nil {
+		return abort(diag.Errorf("failed expiring previous key: %s", err))
+	}
+
+	d.SetId(key.Identifier)
+
+	if err := d.Set("previous_key", currentKey.Identifier); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := setObjectStorageKeyCredentials(d, key.SecretURL, accessKeyID, secretAccessKey); diags.HasError() {
+		return diags
+	}
+
 	return resourceObjectStorageKeyRead(ctx, d, m)
 }
 
 func resourceObjectStorageKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	a := apiFromProviderConfig(m)
-	key := objectstoragev2.Key{Identifier: d.Id()}
 
-	err := a.Destroy(ctx, &key)
-	if err != nil {
-		if err := handleNotFoundError(err); err != nil {
-			return diag.FromErr(err)
+	for _, id := range []string{d.Id(), d.Get("previous_key").(string)} {
+		if id == "" {
+			continue
+		}
+
+		err := a.Destroy(ctx, &objectstoragev2.Key{Identifier: id})
+		if err != nil {
+			if err := handleNotFoundError(err); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
 
@@ -0,0 +1,89 @@
+package anxcloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/mockapi"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+	"go.anx.io/go-anxcloud/pkg/client"
+
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+)
+
+func TestResourceObjectStorageKeyRotateFailure(t *testing.T) {
+	secretServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"access_key_id": "AKID", "secret_access_key": "s3cr3t"}`))
+	}))
+	defer secretServer.Close()
+
+	legacyClient, err := client.New(client.TokenFromString("token"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cases := []struct {
+		Name        string
+		FailGet     bool
+		FailUpdate  bool
+		ExpectError string
+	}{
+		{"retrieving current key fails", true, false, "get failed"},
+		{"expiring current key fails", false, true, "failed expiring previous key: update failed"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			a := mockapi.NewMockAPI(ctrl)
+
+			d := resourceObjectStorageKey().Data(&terraform.InstanceState{
+				ID: "current",
+				Attributes: map[string]string{
+					"name":                     "key",
+					"previous_key":             "previous",
+					"rotation.#":               "1",
+					"rotation.0.validity":      "720h",
+					"rotation.0.rotate_before": "168h",
+					"rotation.0.grace_period":  "1h",
+				},
+			})
+
+			destroyed := []string{}
+			a.EXPECT().Destroy(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, o types.IdentifiedObject, opts ...types.DestroyOption) error {
+				destroyed = append(destroyed, o.(*objectstoragev2.Key).Identifier)
+				return nil
+			})
+			a.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, o types.Object, opts ...types.CreateOption) error {
+				o.(*objectstoragev2.Key).Identifier = "new"
+				o.(*objectstoragev2.Key).SecretURL = secretServer.URL
+				return nil
+			})
+			a.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, o types.IdentifiedObject, opts ...types.GetOption) error {
+				if o.(*objectstoragev2.Key).Identifier == "current" && tc.FailGet {
+					return errors.New("get failed")
+				}
+				return nil
+			})
+			a.EXPECT().Update(gomock.Any(), gomock.Any()).MaxTimes(1).Return(errors.New("update failed"))
+
+			diags := resourceObjectStorageKeyRotate(context.TODO(), d, providerContext{api: a, legacyClient: legacyClient}, objectStorageKeyRotation{})
+			if !diags.HasError() || diags[0].Summary != tc.ExpectError {
+				t.Errorf("expected error %q, got %v", tc.ExpectError, diags)
+			}
+
+			if d.Id() != "current" {
+				t.Errorf("expected state to track the current key, got %q", d.Id())
+			}
+
+			if len(destroyed) != 2 || destroyed[0] != "previous" || destroyed[1] != "new" {
+				t.Errorf("expected previous and new key to be deleted, got %v", destroyed)
+			}
+		})
+	}
+}
@@ -0,0 +1,339 @@
+package anxcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	objectstoragev2 "go.anx.io/go-anxcloud/pkg/apis/objectstorage/v2"
+)
+
+func resourceObjectStorageObject() *schema.Resource {
+	return &schema.Resource{
+		Description:   "This resource allows you to upload objects to Object Storage buckets via the S3 API.",
+		CreateContext: resourceObjectStorageObjectCreate,
+		ReadContext:   resourceObjectStorageObjectRead,
+		UpdateContext: resourceObjectStorageObjectUpdate,
+		DeleteContext: resourceObjectStorageObjectDelete,
+		CustomizeDiff: resourceObjectStorageObjectCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bucket on the S3 endpoint, usually the `actual_name` of an `anxcloud_object_storage_bucket`.",
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Key of the object.",
+			},
+			"backend": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"backend", "endpoint"},
+				Description:  "Identifier of the S3 backend of the bucket, the S3 endpoint is resolved from it.",
+			},
+			"endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "URL of the S3 endpoint. Resolved from `backend` if that is configured instead.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "us-east-1",
+				Description: "Region used to sign requests. Defaults to `us-east-1`.",
+			},
+			"access_key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "S3 access key ID, usually the `access_key_id` of an `anxcloud_object_storage_key`.",
+			},
+			"secret_access_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "S3 secret access key, usually the `secret_access_key` of an `anxcloud_object_storage_key`.",
+			},
+			"content": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"content", "source"},
+				Description:  "Content of the object.",
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of a local file uploaded as content of the object. Files larger than 16 MiB are uploaded in parts.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Content type of the object. Detected from the file extension of `key` or `source`, or from the content, if not configured.",
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.MapKeyMatch(
+					regexp.MustCompile(`^[a-z0-9-]+$`),
+					"metadata keys must only contain lowercase letters, digits and dashes",
+				),
+				Description: "Metadata stored with the object, sent as `x-amz-meta-*` headers.",
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ETag of the object. Changes of the object outside of Terraform are detected by comparing it to the ETag of the configured content.",
+			},
+			"version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the object, if versioning is active for the bucket.",
+			},
+		},
+	}
+}
+
+// openObjectStorageObjectContent returns the configured content or the opened source file with its size
+func openObjectStorageObjectContent(content, source string) (io.ReaderAt, int64, func() error, error) {
+	if source == "" {
+		return strings.NewReader(content), int64(len(content)), func() error { return nil }, nil
+	}
+
+	file, err := os.Open(source)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, nil, err
+	}
+
+	return file, info.Size(), file.Close, nil
+}
+
+func objectStorageObjectETag(content, source string) (string, error) {
+	reader, size, closeContent, err := openObjectStorageObjectContent(content, source)
+	if err != nil {
+		return "", err
+	}
+	defer closeContent()
+
+	return s3ETag(reader, size, s3MultipartPartSize)
+}
+
+// detectObjectStorageObjectContentType returns the content type for the file extension of key or source,
+// falling back to sniffing the content
+func detectObjectStorageObjectContentType(key, source string, content io.ReaderAt) string {
+	for _, name := range []string{key, source} {
+		if contentType := mime.TypeByExtension(path.Ext(name)); contentType != "" {
+			return contentType
+		}
+	}
+
+	head := make([]byte, 512)
+	n, _ := content.ReadAt(head, 0)
+
+	return http.DetectContentType(head[:n])
+}
+
+func resourceObjectStorageObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.HasChange("backend") && d.Get("backend").(string) != "" {
+		if err := d.SetNewComputed("endpoint"); err != nil {
+			return err
+		}
+	}
+
+	if !d.NewValueKnown("content") || !d.NewValueKnown("source") {
+		if err := d.SetNewComputed("etag"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("version_id")
+	}
+
+	etag, err := objectStorageObjectETag(d.Get("content").(string), d.Get("source").(string))
+	if err != nil {
+		return fmt.Errorf("failed calculating ETag of the content: %w", err)
+	}
+
+	if etag != d.Get("etag").(string) {
+		// the ETag returned by the S3 endpoint is only known after the upload
+		if err := d.SetNewComputed("etag"); err != nil {
+			return err
+		}
+	}
+
+	if d.Id() == "" || etag != d.Get("etag").(string) || d.HasChanges("content_type", "metadata") {
+		return d.SetNewComputed("version_id")
+	}
+
+	return nil
+}
+
+// objectStorageObjectS3Client returns a client for the configured S3 endpoint, which is resolved from the backend if configured
+func objectStorageObjectS3Client(ctx context.Context, d *schema.ResourceData, m interface{}) (*s3Client, error) {
+	endpointURL := d.Get("endpoint").(string)
+
+	if backendID := d.Get("backend").(string); backendID != "" {
+		a := apiFromProviderConfig(m)
+
+		backend := objectstoragev2.S3Backend{Identifier: backendID}
+		if err := a.Get(ctx, &backend); err != nil {
+			return nil, fmt.Errorf("failed retrieving backend: %w", err)
+		}
+
+		endpoint := objectstoragev2.Endpoint{Identifier: backend.Endpoint.Identifier}
+		if err := a.Get(ctx, &endpoint); err != nil {
+			return nil, fmt.Errorf("failed retrieving endpoint of backend: %w", err)
+		}
+
+		endpointURL = endpoint.URL
+		if err := d.Set("endpoint", endpointURL); err != nil {
+			return nil, err
+		}
+	}
+
+	return newS3Client(
+		endpointURL,
+		d.Get("region").(string),
+		d.Get("access_key_id").(string),
+		d.Get("secret_access_key").(string),
+	)
+}
+
+func uploadObjectStorageObject(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	client, err := objectStorageObjectS3Client(ctx, d, m)
+	if err != nil {
+		return err
+	}
+
+	source := d.Get("source").(string)
+	content, size, closeContent, err := openObjectStorageObjectContent(d.Get("content").(string), source)
+	if err != nil {
+		return err
+	}
+	defer closeContent()
+
+	contentType := d.Get("content_type").(string)
+	if d.GetRawConfig().GetAttr("content_type").IsNull() {
+		contentType = detectObjectStorageObjectContentType(d.Get("key").(string), source, content)
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	for key, value := range d.Get("metadata").(map[string]interface{}) {
+		header.Set("X-Amz-Meta-"+key, value.(string))
+	}
+
+	info, err := client.putObject(ctx, d.Get("bucket").(string), d.Get("key").(string), content, size, header)
+	if err != nil {
+		return fmt.Errorf("failed uploading object: %w", err)
+	}
+
+	if err := d.Set("etag", info.ETag); err != nil {
+		return err
+	}
+
+	return d.Set("version_id", info.VersionID)
+}
+
+func resourceObjectStorageObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := uploadObjectStorageObject(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("bucket").(string) + "/" + d.Get("key").(string))
+
+	return resourceObjectStorageObjectRead(ctx, d, m)
+}
+
+func resourceObjectStorageObjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, err := objectStorageObjectS3Client(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	info, err := client.headObject(ctx, d.Get("bucket").(string), d.Get("key").(string))
+	if err != nil {
+		var s3Err *s3Error
+		if errors.As(err, &s3Err) && s3Err.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("etag", info.ETag); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("version_id", info.VersionID); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("content_type", info.ContentType); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("metadata", info.Metadata); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceObjectStorageObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("content", "source", "content_type", "metadata", "etag") {
+		if err := uploadObjectStorageObject(ctx, d, m); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceObjectStorageObjectRead(ctx, d, m)
+}
+
+func resourceObjectStorageObjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := objectStorageObjectS3Client(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.deleteObject(ctx, d.Get("bucket").(string), d.Get("key").(string)); err != nil {
+		var s3Err *s3Error
+		if !errors.As(err, &s3Err) || s3Err.StatusCode != http.StatusNotFound {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
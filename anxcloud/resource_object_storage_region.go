@@ -12,18 +12,21 @@ import (
 
 func resourceObjectStorageRegion() *schema.Resource {
 	return &schema.Resource{
-		Description:   "This resource allows you to configure Object Storage regions.",
-		CreateContext: resourceObjectStorageRegionCreate,
-		ReadContext:   resourceObjectStorageRegionRead,
-		UpdateContext: resourceObjectStorageRegionUpdate,
+		Description:   "This resource allows you to configure Object Storage regions. Regions are imported with an ID of the form `<customer>/<backend>/<identifier>` or just `<customer>/<identifier>` if it has no backend.",
+		CreateContext: tagsMiddlewareCreate(resourceObjectStorageRegionCreate),
+		ReadContext:   tagsMiddlewareRead(resourceObjectStorageRegionRead),
+		UpdateContext: tagsMiddlewareUpdate(resourceObjectStorageRegionUpdate),
 		DeleteContext: resourceObjectStorageRegionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateObjectStorage(true, "backend"),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Read:   schema.DefaultTimeout(1 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
-		Schema: mergeSchemas(
+		Schema: withTagsAttribute(mergeSchemas(
 			schemaObjectStorageCommon(),
 			schemaObjectStorageState(),
 			map[string]*schema.Schema{
@@ -44,7 +47,7 @@ func resourceObjectStorageRegion() *schema.Resource {
 					Description: "Identifier of the S3 backend this region belongs to.",
 				},
 			},
-		),
+		)),
 	}
 }
 
@@ -70,6 +73,11 @@ func resourceObjectStorageRegionCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	d.SetId(region.Identifier)
+
+	if diags := waitForObjectStorageState(ctx, a, &objectstoragev2.Region{Identifier: region.Identifier}, "region", d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		return diags
+	}
+
 	return resourceObjectStorageRegionRead(ctx, d, m)
 }
 
@@ -129,6 +137,10 @@ func resourceObjectStorageRegionUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
+	if diags := waitForObjectStorageState(ctx, a, &objectstoragev2.Region{Identifier: d.Id()}, "region", d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+		return diags
+	}
+
 	return resourceObjectStorageRegionRead(ctx, d, m)
 }
 
@@ -2,6 +2,7 @@ package anxcloud
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -15,13 +16,14 @@ import (
 
 func resourceObjectStorageTenant() *schema.Resource {
 	return &schema.Resource{
-		Description:   "This resource allows you to create and manage Object Storage tenants.",
-		CreateContext: resourceObjectStorageTenantCreate,
-		ReadContext:   resourceObjectStorageTenantRead,
-		UpdateContext: resourceObjectStorageTenantUpdate,
+		Description:   "This resource allows you to create and manage Object Storage tenants. Tenants are imported with an ID of the form `<customer>/<backend>/<identifier>`.",
+		CreateContext: tagsMiddlewareCreate(resourceObjectStorageTenantCreate),
+		ReadContext:   tagsMiddlewareRead(resourceObjectStorageTenantRead),
+		UpdateContext: tagsMiddlewareUpdate(resourceObjectStorageTenantUpdate),
 		DeleteContext: resourceObjectStorageTenantDelete,
+		CustomizeDiff: resourceObjectStorageTenantCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateObjectStorage(false, "backend"),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
@@ -29,7 +31,7 @@ func resourceObjectStorageTenant() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
-		Schema: schemaObjectStorageTenant(),
+		Schema: withTagsAttribute(schemaObjectStorageTenant()),
 	}
 }
 
@@ -70,6 +72,26 @@ func schemaObjectStorageTenant() map[string]*schema.Schema {
 				Computed:    true,
 				Description: "Current number of bytes used by the user within buckets.",
 			},
+			"quota_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{objectStorageQuotaPolicyWarn, objectStorageQuotaPolicyDeny}, false),
+				Description: "Checks the usage of the tenant against its `quota` when planning. With `warn`, a warning is shown " +
+					"once the usage reaches `quota_alert_threshold`. With `deny`, the plan additionally fails while the usage " +
+					"exceeds the planned `quota`, so it has to be raised first. The usage is the larger one of `usage` and `bucket_usage`.",
+			},
+			"quota_alert_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0.9,
+				ValidateFunc: validation.FloatBetween(0, 1),
+				Description:  "Fraction of the `quota` from which on a warning is shown if `quota_policy` is set. Defaults to 0.9.",
+			},
+			"bucket_usage": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Collective size of objects in the buckets of the tenant. Only calculated if `quota_policy` is set.",
+			},
 			"backend": schemaObjectStorageReference(),
 			"remote_id": {
 				Type:        schema.TypeString,
@@ -80,6 +102,43 @@ func schemaObjectStorageTenant() map[string]*schema.Schema {
 	)
 }
 
+func resourceObjectStorageTenantCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" || d.Get("quota_policy").(string) != objectStorageQuotaPolicyDeny {
+		return nil
+	}
+
+	quota := d.Get("quota").(float64)
+	used := objectStorageQuotaUsage(d.Get("usage").(float64), d.Get("bucket_usage").(float64))
+
+	if used > quota {
+		return fmt.Errorf(
+			"usage of tenant %q (%s) exceeds its quota of %s, raise the quota or free up space in its buckets",
+			d.Get("name").(string), formatObjectStorageBytes(used), formatObjectStorageBytes(quota),
+		)
+	}
+
+	return nil
+}
+
+// checkObjectStorageTenantQuota returns a warning if the tenant reached the alert threshold of its quota
+func checkObjectStorageTenantQuota(d *schema.ResourceData) diag.Diagnostics {
+	quota := d.Get("quota").(float64)
+	used := objectStorageQuotaUsage(d.Get("usage").(float64), d.Get("bucket_usage").(float64))
+
+	if quota <= 0 || used < quota*d.Get("quota_alert_threshold").(float64) {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Object Storage tenant %q used %.1f%% of its quota", d.Get("name").(string), objectStorageQuotaPercentage(quota, used)),
+		Detail: fmt.Sprintf(
+			"The tenant uses %s of its quota of %s, the collective size of objects in its buckets is %s.",
+			formatObjectStorageBytes(used), formatObjectStorageBytes(quota), formatObjectStorageBytes(d.Get("bucket_usage").(float64)),
+		),
+	}}
+}
+
 func resourceObjectStorageTenantCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	a := apiFromProviderConfig(m)
 
@@ -108,6 +167,11 @@ func resourceObjectStorageTenantCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	d.SetId(tenant.Identifier)
+
+	if diags := waitForObjectStorageState(ctx, a, &objectstoragev2.Tenant{Identifier: tenant.Identifier}, "tenant", d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		return diags
+	}
+
 	return resourceObjectStorageTenantRead(ctx, d, m)
 }
 
@@ -163,6 +227,23 @@ func resourceObjectStorageTenantRead(ctx context.Context, d *schema.ResourceData
 	setObjectStorageCommonFieldsFromAPI(&tenant, d, &diags)
 	setObjectStorageStateFieldFromAPI(&tenant, d, &diags)
 
+	if d.Get("quota_policy").(string) != "" {
+		bucketUsage, err := objectStorageTenantBucketUsage(ctx, a, tenant.Identifier)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed calculating bucket usage of the tenant",
+				Detail:   err.Error(),
+			})
+		} else if err := d.Set("bucket_usage", bucketUsage); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+
+		diags = append(diags, checkObjectStorageTenantQuota(d)...)
+	} else if err := d.Set("bucket_usage", 0); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
 	return diags
 }
 
@@ -194,6 +275,10 @@ func resourceObjectStorageTenantUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
+	if diags := waitForObjectStorageState(ctx, a, &objectstoragev2.Tenant{Identifier: d.Id()}, "tenant", d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+		return diags
+	}
+
 	return resourceObjectStorageTenantRead(ctx, d, m)
 }
 
@@ -12,18 +12,21 @@ import (
 
 func resourceObjectStorageUser() *schema.Resource {
 	return &schema.Resource{
-		Description:   "This resource allows you to configure Object Storage users.",
-		CreateContext: resourceObjectStorageUserCreate,
-		ReadContext:   resourceObjectStorageUserRead,
-		UpdateContext: resourceObjectStorageUserUpdate,
+		Description:   "This resource allows you to configure Object Storage users. Users are imported with an ID of the form `<customer>/<backend>/<identifier>`.",
+		CreateContext: tagsMiddlewareCreate(resourceObjectStorageUserCreate),
+		ReadContext:   tagsMiddlewareRead(resourceObjectStorageUserRead),
+		UpdateContext: tagsMiddlewareUpdate(resourceObjectStorageUserUpdate),
 		DeleteContext: resourceObjectStorageUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateObjectStorage(false, "backend"),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Read:   schema.DefaultTimeout(1 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
-		Schema: mergeSchemas(
+		Schema: withTagsAttribute(mergeSchemas(
 			schemaObjectStorageCommon(),
 			schemaObjectStorageState(),
 			map[string]*schema.Schema{
@@ -59,7 +62,7 @@ func resourceObjectStorageUser() *schema.Resource {
 					Description: "Remote identifier of the user.",
 				},
 			},
-		),
+		)),
 	}
 }
 
@@ -90,6 +93,11 @@ func resourceObjectStorageUserCreate(ctx context.Context, d *schema.ResourceData
 	}
 
 	d.SetId(user.Identifier)
+
+	if diags := waitForObjectStorageState(ctx, a, &objectstoragev2.User{Identifier: user.Identifier}, "user", d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		return diags
+	}
+
 	return resourceObjectStorageUserRead(ctx, d, m)
 }
 
@@ -170,6 +178,10 @@ func resourceObjectStorageUserUpdate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
+	if diags := waitForObjectStorageState(ctx, a, &objectstoragev2.User{Identifier: d.Id()}, "user", d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+		return diags
+	}
+
 	return resourceObjectStorageUserRead(ctx, d, m)
 }
 
@@ -0,0 +1,178 @@
+package anxcloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"go.anx.io/go-anxcloud/pkg/api"
+	corev1 "go.anx.io/go-anxcloud/pkg/apis/core/v1"
+)
+
+func resourceResourceTags() *schema.Resource {
+	return &schema.Resource{
+		Description: "The resource_tags resource allows you to manage the tags of any resource by its identifier, " +
+			"including resources not managed by Terraform or without `tags` attribute. By default only the configured tags " +
+			"are added and removed, tags attached otherwise are kept. With `authoritative` enabled, all other tags are removed " +
+			"from the resource. Don't combine this resource with the `tags` attribute of the same resource, they would " +
+			"overwrite each others changes. Resource tags are imported by the identifier of the tagged resource.",
+		CreateContext: resourceResourceTagsCreate,
+		ReadContext:   resourceResourceTagsRead,
+		UpdateContext: resourceResourceTagsUpdate,
+		DeleteContext: resourceResourceTagsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceResourceTagsImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Identifier of the tagged resource.",
+			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Set of tags attached to the resource.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"authoritative": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If enabled, tags not configured in `tags` are removed from the resource. Defaults to false.",
+			},
+		},
+	}
+}
+
+// updateResourceTags attaches the configured tags to the resource. Previously configured tags are removed
+// if they aren't configured anymore, other tags only if authoritative is set.
+func updateResourceTags(ctx context.Context, a api.API, resourceID string, previous, tags []string, authoritative bool) error {
+	if authoritative {
+		return ensureTags(ctx, a, resourceID, tags)
+	}
+
+	remote, err := readTags(ctx, a, resourceID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch remote tags: %w", err)
+	}
+
+	resource := corev1.Resource{Identifier: resourceID}
+
+	toRemove := sliceIntersect(sliceSubstract(previous, tags), remote)
+	if err := corev1.Untag(ctx, a, &resource, toRemove...); err != nil {
+		return fmt.Errorf("failed to untag resource: %w", err)
+	}
+
+	toAdd := sliceSubstract(tags, remote)
+	if err := corev1.Tag(ctx, a, &resource, toAdd...); err != nil {
+		return fmt.Errorf("failed to tag resource: %w", err)
+	}
+
+	return nil
+}
+
+func resourceResourceTagsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+	resourceID := d.Get("resource_id").(string)
+	tags := mustCastInterfaceArray[string](d.Get("tags").(*schema.Set).List())
+
+	if err := updateResourceTags(ctx, a, resourceID, nil, tags, d.Get("authoritative").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resourceID)
+
+	return resourceResourceTagsRead(ctx, d, m)
+}
+
+func resourceResourceTagsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	remote, err := readTags(ctx, a, d.Id())
+	if api.IgnoreNotFound(err) != nil {
+		return diag.FromErr(err)
+	} else if err != nil {
+		d.SetId("")
+		return nil
+	}
+
+	tags := remote
+	if !d.Get("authoritative").(bool) {
+		// tags attached otherwise aren't managed by this resource
+		tags = sliceIntersect(mustCastInterfaceArray[string](d.Get("tags").(*schema.Set).List()), remote)
+	}
+
+	var diags diag.Diagnostics
+	if err := d.Set("resource_id", d.Id()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("tags", tags); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceResourceTagsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	previous, tags := d.GetChange("tags")
+	err := updateResourceTags(
+		ctx, a, d.Id(),
+		mustCastInterfaceArray[string](previous.(*schema.Set).List()),
+		mustCastInterfaceArray[string](tags.(*schema.Set).List()),
+		d.Get("authoritative").(bool),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceResourceTagsRead(ctx, d, m)
+}
+
+func resourceResourceTagsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	remote, err := readTags(ctx, a, d.Id())
+	if api.IgnoreNotFound(err) != nil {
+		return diag.FromErr(err)
+	} else if err != nil {
+		return nil
+	}
+
+	tags := sliceIntersect(mustCastInterfaceArray[string](d.Get("tags").(*schema.Set).List()), remote)
+	if err := corev1.Untag(ctx, a, &corev1.Resource{Identifier: d.Id()}, tags...); err != nil {
+		return diag.Errorf("failed to untag resource: %s", err)
+	}
+
+	return nil
+}
+
+// resourceResourceTagsImport manages all tags attached to the resource at the time of the import
+func resourceResourceTagsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	remote, err := readTags(ctx, apiFromProviderConfig(m), d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch remote tags: %w", err)
+	}
+
+	if err := d.Set("tags", remote); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,57 @@
+package anxcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/testutils/environment"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAnxCloudResourceTags(t *testing.T) {
+	environment.SkipIfNoEnvironment(t)
+
+	tpl := fmt.Sprintf(`
+	resource "anxcloud_e5e_application" "foo" {
+		name = "terraform-test-resource-tags-%s"
+	}
+
+	resource "anxcloud_resource_tags" "foo" {
+		resource_id   = anxcloud_e5e_application.foo.id
+		tags          = %%s
+		authoritative = %%t
+	}`, environment.GetEnvInfo(t).TestRunName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(tpl, `["foo", "bar"]`, false),
+				Check:  testAccAnxCloudCheckResourceTagged("anxcloud_e5e_application.foo", "foo", "bar"),
+			},
+			// attach a tag not managed by anxcloud_resource_tags
+			{
+				ImportStateIdFunc: testAccAnxCloudAddRemoteTag("anxcloud_e5e_application.foo", "foobaz"),
+				ImportState:       true,
+				ResourceName:      "anxcloud_e5e_application.foo",
+			},
+			// non-authoritative mode keeps other tags
+			{
+				Config: fmt.Sprintf(tpl, `["foo"]`, false),
+				Check:  testAccAnxCloudCheckResourceTagged("anxcloud_e5e_application.foo", "foo", "foobaz"),
+			},
+			// authoritative mode removes other tags
+			{
+				Config: fmt.Sprintf(tpl, `["foo", "bar"]`, true),
+				Check:  testAccAnxCloudCheckResourceTagged("anxcloud_e5e_application.foo", "foo", "bar"),
+			},
+			{
+				ResourceName:            "anxcloud_resource_tags.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"authoritative"},
+			},
+		},
+	})
+}
@@ -2,89 +2,315 @@ package anxcloud
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"go.anx.io/go-anxcloud/pkg/core/tags"
-)
+	"go.anx.io/go-anxcloud/pkg/api"
 
-// TODO tags currently only works if they are attached to a compute resource.
-// we’ll need a rewrite of it after we come to a second service which is also using tags.
+	corev1internal "github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/apis/core/v1"
+)
 
 func resourceTag() *schema.Resource {
 	return &schema.Resource{
-		Description:   "The tag resource allows you to create and configure a tag.",
+		Description: "The tag resource allows you to create a tag and assign it to one or more services and organisations, " +
+			"e.g. to create shared tags upfront which are attached to resources later on.",
 		CreateContext: resourceTagCreate,
 		ReadContext:   resourceTagRead,
+		UpdateContext: resourceTagUpdate,
 		DeleteContext: resourceTagDelete,
+		CustomizeDiff: resourceTagCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceTagImport,
 		},
 		Schema: schemaTag(),
 	}
 }
 
-func resourceTagCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(providerContext).legacyClient
-	t := tags.NewAPI(c)
+// tagAssignment is the assignment of a tag to a service, an empty customerID stands for the
+// organisation of the logged in user
+type tagAssignment struct {
+	serviceID  string
+	customerID string
+}
+
+// matches returns true if the organisation assignment returned by the API fulfills the assignment
+func (a tagAssignment) matches(organisation corev1internal.TagOrganisation) bool {
+	return organisation.Service.Identifier == a.serviceID &&
+		(a.customerID == "" || organisation.Customer.Identifier == a.customerID)
+}
+
+// expandTagAssignments returns the assignments of either the deprecated service_id and customer_id
+// attributes or the assignment blocks
+func expandTagAssignments(serviceID, customerID interface{}, assignments interface{}) []tagAssignment {
+	if serviceID.(string) != "" {
+		return []tagAssignment{{serviceID: serviceID.(string), customerID: customerID.(string)}}
+	}
+
+	var ret []tagAssignment
+	for _, assignment := range assignments.(*schema.Set).List() {
+		assignment := assignment.(map[string]interface{})
+		ret = append(ret, tagAssignment{
+			serviceID:  assignment["service_id"].(string),
+			customerID: assignment["customer_id"].(string),
+		})
+	}
+
+	return ret
+}
+
+func tagAssignmentsFromResourceData(d *schema.ResourceData) []tagAssignment {
+	return expandTagAssignments(d.Get("service_id"), d.Get("customer_id"), d.Get("assignment"))
+}
+
+func createTagAssignments(ctx context.Context, a api.API, name string, assignments []tagAssignment) (string, error) {
+	var identifier string
+
+	for _, assignment := range assignments {
+		tag := corev1internal.Tag{
+			Name:               name,
+			ServiceIdentifier:  assignment.serviceID,
+			CustomerIdentifier: assignment.customerID,
+		}
 
-	def := tags.Create{
-		Name:       d.Get("name").(string),
-		ServiceID:  d.Get("service_id").(string),
-		CustomerID: d.Get("customer_id").(string),
+		if err := a.Create(ctx, &tag); err != nil {
+			return identifier, fmt.Errorf("failed assigning tag to service %q: %w", assignment.serviceID, err)
+		}
+
+		identifier = tag.Identifier
 	}
 
-	res, err := t.Create(ctx, def)
+	return identifier, nil
+}
+
+func destroyTagAssignments(ctx context.Context, a api.API, identifier string, assignments []tagAssignment) error {
+	for _, assignment := range assignments {
+		err := a.Destroy(ctx, &corev1internal.TagAssignment{TagIdentifier: identifier, ServiceIdentifier: assignment.serviceID})
+		if api.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed removing tag from service %q: %w", assignment.serviceID, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceTagCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+
+	identifier, err := createTagAssignments(ctx, a, d.Get("name").(string), tagAssignmentsFromResourceData(d))
+	if identifier != "" {
+		d.SetId(identifier)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	d.SetId(res.Identifier)
 
 	return resourceTagRead(ctx, d, m)
 }
 
 func resourceTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags []diag.Diagnostic
+	var diags diag.Diagnostics
+	a := apiFromProviderConfig(m)
 
-	c := m.(providerContext).legacyClient
-	t := tags.NewAPI(c)
-
-	info, err := t.Get(ctx, d.Id())
-	if err != nil {
-		if err := handleNotFoundError(err); err != nil {
-			return diag.FromErr(err)
-		}
+	tag := corev1internal.Tag{Identifier: d.Id()}
+	if err := a.Get(ctx, &tag); api.IgnoreNotFound(err) != nil {
+		return diag.FromErr(err)
+	} else if err != nil {
 		d.SetId("")
 		return nil
 	}
 
-	if err := d.Set("name", info.Name); err != nil {
+	if err := d.Set("name", tag.Name); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
-	err = d.Set("service_id", info.Organisations[0].Service.Identifier)
-	if err != nil {
-		diags = append(diags, diag.FromErr(err)...)
+
+	// configured assignments which were removed are added again on the next apply,
+	// assignments made outside of Terraform are only listed in organisation_assignments
+	if serviceID := d.Get("service_id").(string); serviceID != "" {
+		assignment := tagAssignment{serviceID: serviceID, customerID: d.Get("customer_id").(string)}
+		if !tagAssignedTo(tag.Organisations, assignment) {
+			if err := d.Set("service_id", ""); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
+	} else {
+		assignments := make([]interface{}, 0)
+		for _, assignment := range tagAssignmentsFromResourceData(d) {
+			if tagAssignedTo(tag.Organisations, assignment) {
+				assignments = append(assignments, map[string]interface{}{
+					"service_id":  assignment.serviceID,
+					"customer_id": assignment.customerID,
+				})
+			}
+		}
+
+		if err := d.Set("assignment", assignments); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
 	}
-	if err := d.Set("organisation_assignments", flattenOrganisationAssignments(info.Organisations)); err != nil {
+
+	if err := d.Set("organisation_assignments", flattenOrganisationAssignments(tag.Organisations)); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
 	return diags
 }
 
+func tagAssignedTo(organisations []corev1internal.TagOrganisation, assignment tagAssignment) bool {
+	for _, organisation := range organisations {
+		if assignment.matches(organisation) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// diffTagAssignments returns the assignments to remove and to add to get from previous to current.
+// Assignments are removed by service, so all current assignments of a service which loses one of its
+// assignments, e.g. because its customer changed, are returned as to reassign: the service is removed
+// and these assignments are added again.
+func diffTagAssignments(previous, current []tagAssignment) ([]tagAssignment, []tagAssignment, []tagAssignment) {
+	removed := sliceSubstract(previous, current)
+	added := sliceSubstract(current, previous)
+
+	reassignedServices := make(map[string]bool)
+	toRemove := make([]tagAssignment, 0, len(removed))
+	for _, assignment := range removed {
+		if slices.ContainsFunc(current, func(c tagAssignment) bool { return c.serviceID == assignment.serviceID }) {
+			reassignedServices[assignment.serviceID] = true
+		} else {
+			toRemove = append(toRemove, assignment)
+		}
+	}
+
+	toAdd := make([]tagAssignment, 0, len(added))
+	for _, assignment := range added {
+		if !reassignedServices[assignment.serviceID] {
+			toAdd = append(toAdd, assignment)
+		}
+	}
+
+	toReassign := make([]tagAssignment, 0)
+	for _, assignment := range current {
+		if reassignedServices[assignment.serviceID] {
+			toReassign = append(toReassign, assignment)
+		}
+	}
+
+	return toRemove, toAdd, toReassign
+}
+
+// resourceTagCustomizeDiff replaces the tag when all of its assignments belong to a single service which
+// has to be reassigned, the tag is deleted with its last assignment
+func resourceTagCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	oldServiceID, newServiceID := d.GetChange("service_id")
+	oldCustomerID, newCustomerID := d.GetChange("customer_id")
+	oldAssignments, newAssignments := d.GetChange("assignment")
+
+	current := expandTagAssignments(newServiceID, newCustomerID, newAssignments)
+	_, _, toReassign := diffTagAssignments(
+		expandTagAssignments(oldServiceID, oldCustomerID, oldAssignments),
+		current,
+	)
+
+	if len(toReassign) == 0 || len(toReassign) != len(current) {
+		return nil
+	}
+
+	for _, assignment := range toReassign {
+		if assignment.serviceID != toReassign[0].serviceID {
+			return nil
+		}
+	}
+
+	if newServiceID.(string) != "" {
+		return d.ForceNew("customer_id")
+	}
+
+	return d.ForceNew("assignment")
+}
+
+func resourceTagUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	a := apiFromProviderConfig(m)
+	name := d.Get("name").(string)
+
+	oldServiceID, newServiceID := d.GetChange("service_id")
+	oldCustomerID, newCustomerID := d.GetChange("customer_id")
+	oldAssignments, newAssignments := d.GetChange("assignment")
+
+	toRemove, toAdd, toReassign := diffTagAssignments(
+		expandTagAssignments(oldServiceID, oldCustomerID, oldAssignments),
+		expandTagAssignments(newServiceID, newCustomerID, newAssignments),
+	)
+
+	// add assignments first, the tag is deleted with its last assignment
+	if _, err := createTagAssignments(ctx, a, name, toAdd); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := destroyTagAssignments(ctx, a, d.Id(), toRemove); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// assignments of other services are kept while a service is reassigned, see resourceTagCustomizeDiff
+	reassignedServices := make(map[string]bool)
+	for _, assignment := range toReassign {
+		if !reassignedServices[assignment.serviceID] {
+			reassignedServices[assignment.serviceID] = true
+			if err := destroyTagAssignments(ctx, a, d.Id(), []tagAssignment{assignment}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if _, err := createTagAssignments(ctx, a, name, []tagAssignment{assignment}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceTagRead(ctx, d, m)
+}
+
 func resourceTagDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(providerContext).legacyClient
-	t := tags.NewAPI(c)
+	a := apiFromProviderConfig(m)
 
-	if err := t.Delete(ctx, d.Id(), d.Get("service_id").(string)); err != nil {
+	if err := destroyTagAssignments(ctx, a, d.Id(), tagAssignmentsFromResourceData(d)); err != nil {
 		return diag.FromErr(err)
 	}
 
 	return nil
 }
+
+// resourceTagImport manages all assignments of the tag at the time of the import
+func resourceTagImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	tag := corev1internal.Tag{Identifier: d.Id()}
+	if err := apiFromProviderConfig(m).Get(ctx, &tag); err != nil {
+		return nil, err
+	}
+
+	assignments := make([]interface{}, 0, len(tag.Organisations))
+	for _, organisation := range tag.Organisations {
+		assignments = append(assignments, map[string]interface{}{
+			"service_id":  organisation.Service.Identifier,
+			"customer_id": organisation.Customer.Identifier,
+		})
+	}
+
+	if err := d.Set("assignment", assignments); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -5,9 +5,16 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/mockapi"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"go.anx.io/go-anxcloud/pkg/core/tags"
+	"go.anx.io/go-anxcloud/pkg/api"
+	"go.anx.io/go-anxcloud/pkg/api/types"
+
+	corev1internal "github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/apis/core/v1"
 )
 
 func TestAccAnxCloudTag(t *testing.T) {
@@ -29,21 +36,31 @@ func TestAccAnxCloudTag(t *testing.T) {
 					testAccAnxCloudTagExists(resourcePath),
 				),
 			},
+			{
+				Config: testAccAnxCloudTagAssignment(resourceName, serviceID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourcePath, "service_id", ""),
+					resource.TestCheckResourceAttr(resourcePath, "assignment.#", "1"),
+					resource.TestCheckResourceAttr(resourcePath, "organisation_assignments.#", "1"),
+					testAccAnxCloudTagExists(resourcePath),
+				),
+			},
 			{
 				ResourceName:      resourcePath,
 				ImportState:       true,
 				ImportStateVerify: true,
+				// assignments are imported with the organisation they are assigned to
+				ImportStateVerifyIgnore: []string{"assignment"},
 			},
 		},
 	})
 }
 
 func testAccCheckAnxCloudTagDestroy(s *terraform.State) error {
-	c := testAccProvider.Meta().(providerContext).legacyClient
-	t := tags.NewAPI(c)
+	a := testAccProvider.Meta().(providerContext).api
 	ctx := context.Background()
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "anxcloud_network_prefix" {
+		if rs.Type != "anxcloud_tag" {
 			continue
 		}
 
@@ -51,12 +68,10 @@ func testAccCheckAnxCloudTagDestroy(s *terraform.State) error {
 			return nil
 		}
 
-		_, err := t.Get(ctx, rs.Primary.ID)
-		if err != nil {
-			if err := handleNotFoundError(err); err != nil {
-				return err
-			}
-			return nil
+		if err := a.Get(ctx, &corev1internal.Tag{Identifier: rs.Primary.ID}); api.IgnoreNotFound(err) != nil {
+			return err
+		} else if err == nil {
+			return fmt.Errorf("tag '%s' exists", rs.Primary.ID)
 		}
 	}
 
@@ -72,11 +87,22 @@ func testAccAnxCloudTag(resourceName, serviceID string) string {
 	`, resourceName, resourceName, serviceID)
 }
 
+func testAccAnxCloudTagAssignment(resourceName, serviceID string) string {
+	return fmt.Sprintf(`
+	resource "anxcloud_tag" "%s" {
+		name = "%s"
+
+		assignment {
+			service_id = "%s"
+		}
+	}
+	`, resourceName, resourceName, serviceID)
+}
+
 func testAccAnxCloudTagExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
-		c := testAccProvider.Meta().(providerContext).legacyClient
-		t := tags.NewAPI(c)
+		a := testAccProvider.Meta().(providerContext).api
 		ctx := context.Background()
 
 		if !ok {
@@ -87,11 +113,193 @@ func testAccAnxCloudTagExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("tag id not set")
 		}
 
-		_, err := t.Get(ctx, rs.Primary.ID)
-		if err != nil {
-			return err
+		return a.Get(ctx, &corev1internal.Tag{Identifier: rs.Primary.ID})
+	}
+}
+
+func TestDiffTagAssignments(t *testing.T) {
+	cases := []struct {
+		Name             string
+		Previous         []tagAssignment
+		Current          []tagAssignment
+		ExpectedRemove   []tagAssignment
+		ExpectedAdd      []tagAssignment
+		ExpectedReassign []tagAssignment
+	}{
+		{
+			"services changed",
+			[]tagAssignment{{serviceID: "service-a"}, {serviceID: "service-b", customerID: "customer"}},
+			[]tagAssignment{{serviceID: "service-b", customerID: "customer"}, {serviceID: "service-c"}},
+			[]tagAssignment{{serviceID: "service-a"}},
+			[]tagAssignment{{serviceID: "service-c"}},
+			[]tagAssignment{},
+		},
+		{
+			"customer of service changed",
+			[]tagAssignment{{serviceID: "service-a", customerID: "customer-a"}, {serviceID: "service-b"}},
+			[]tagAssignment{{serviceID: "service-a", customerID: "customer-b"}, {serviceID: "service-b"}},
+			[]tagAssignment{},
+			[]tagAssignment{},
+			[]tagAssignment{{serviceID: "service-a", customerID: "customer-b"}},
+		},
+		{
+			"customer removed from service with multiple customers",
+			[]tagAssignment{{serviceID: "service-a", customerID: "customer-a"}, {serviceID: "service-a", customerID: "customer-b"}},
+			[]tagAssignment{{serviceID: "service-a", customerID: "customer-a"}, {serviceID: "service-c"}},
+			[]tagAssignment{},
+			[]tagAssignment{{serviceID: "service-c"}},
+			[]tagAssignment{{serviceID: "service-a", customerID: "customer-a"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			toRemove, toAdd, toReassign := diffTagAssignments(tc.Previous, tc.Current)
+
+			if diff := cmp.Diff(tc.ExpectedRemove, toRemove, cmp.AllowUnexported(tagAssignment{})); diff != "" {
+				t.Errorf("unexpected assignments to remove (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.ExpectedAdd, toAdd, cmp.AllowUnexported(tagAssignment{})); diff != "" {
+				t.Errorf("unexpected assignments to add (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.ExpectedReassign, toReassign, cmp.AllowUnexported(tagAssignment{})); diff != "" {
+				t.Errorf("unexpected assignments to reassign (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTagUpdateReassignsService(t *testing.T) {
+	assignments := func(customerID string) map[string]interface{} {
+		return map[string]interface{}{
+			"name": "shared",
+			"assignment": []interface{}{
+				map[string]interface{}{"service_id": "service-a", "customer_id": customerID},
+				map[string]interface{}{"service_id": "service-b", "customer_id": ""},
+			},
 		}
+	}
+
+	cases := []struct {
+		Name          string
+		Previous      map[string]interface{}
+		Current       map[string]interface{}
+		ExpectReplace bool
+	}{
+		{
+			"deprecated customer_id changed",
+			map[string]interface{}{"name": "shared", "service_id": "service-a", "customer_id": "customer-a"},
+			map[string]interface{}{"name": "shared", "service_id": "service-a", "customer_id": "customer-b"},
+			true,
+		},
+		{
+			"customer of only assignment changed",
+			map[string]interface{}{"name": "shared", "assignment": []interface{}{map[string]interface{}{"service_id": "service-a", "customer_id": "customer-a"}}},
+			map[string]interface{}{"name": "shared", "assignment": []interface{}{map[string]interface{}{"service_id": "service-a", "customer_id": "customer-b"}}},
+			true,
+		},
+		{
+			"customer changed with other assignments",
+			assignments("customer-a"),
+			assignments("customer-b"),
+			false,
+		},
+	}
 
-		return nil
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			previous := schema.TestResourceDataRaw(t, schemaTag(), tc.Previous)
+			previous.SetId("tag-id")
+			state := previous.State()
+
+			diff, err := resourceTag().Diff(context.Background(), state, terraform.NewResourceConfigRaw(tc.Current), nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff.RequiresNew() != tc.ExpectReplace {
+				t.Fatalf("expected replacement to be %t, got %t", tc.ExpectReplace, diff.RequiresNew())
+			}
+
+			if tc.ExpectReplace {
+				return
+			}
+
+			d, err := schema.InternalMap(schemaTag()).Data(state, diff)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			ctrl := gomock.NewController(t)
+			a := mockapi.NewMockAPI(ctrl)
+
+			gomock.InOrder(
+				a.EXPECT().Destroy(gomock.Any(), &corev1internal.TagAssignment{TagIdentifier: "tag-id", ServiceIdentifier: "service-a"}),
+				a.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, o types.Object, opts ...types.CreateOption) error {
+					if tag := o.(*corev1internal.Tag); tag.ServiceIdentifier != "service-a" || tag.CustomerIdentifier != "customer-b" {
+						t.Errorf("unexpected assignment %+v", tag)
+					}
+					return nil
+				}),
+				a.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil),
+			)
+
+			if diags := resourceTagUpdate(context.Background(), d, providerContext{api: a}); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+		})
+	}
+}
+
+func TestTagRead(t *testing.T) {
+	cases := []struct {
+		Name                string
+		Organisations       []corev1internal.TagOrganisation
+		ExpectedAssignments int
+	}{
+		{"without assignments", nil, 0},
+		{"assigned", []corev1internal.TagOrganisation{{
+			Customer: corev1internal.TagCustomer{Identifier: "customer"},
+			Service:  corev1internal.TagService{Identifier: "service-a"},
+		}}, 1},
+		{"assigned to other organisation", []corev1internal.TagOrganisation{{
+			Customer: corev1internal.TagCustomer{Identifier: "other-customer"},
+			Service:  corev1internal.TagService{Identifier: "service-a"},
+		}}, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			a := mockapi.NewMockAPI(ctrl)
+			a.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, o types.IdentifiedObject, opts ...types.GetOption) error {
+				tag := o.(*corev1internal.Tag)
+				tag.Name = "shared"
+				tag.Organisations = tc.Organisations
+				return nil
+			})
+
+			d := schema.TestResourceDataRaw(t, schemaTag(), map[string]interface{}{
+				"name": "shared",
+				"assignment": []interface{}{
+					map[string]interface{}{"service_id": "service-a", "customer_id": "customer"},
+				},
+			})
+			d.SetId("tag-id")
+
+			if diags := resourceTagRead(context.Background(), d, providerContext{api: a}); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if assignments := d.Get("assignment").(*schema.Set).Len(); assignments != tc.ExpectedAssignments {
+				t.Errorf("expected %d assignments, got %d", tc.ExpectedAssignments, assignments)
+			}
+
+			if organisations := len(d.Get("organisation_assignments").([]interface{})); organisations != len(tc.Organisations) {
+				t.Errorf("expected %d organisation assignments, got %d", len(tc.Organisations), organisations)
+			}
+		})
 	}
 }
@@ -57,6 +57,8 @@ The virtual_server resource allows you to configure and run virtual machines.
 				old, newNetworks := d.GetChange("network")
 				oldNets := expandVirtualServerNetworks(old.([]interface{}))
 				newNets := expandVirtualServerNetworks(newNetworks.([]interface{}))
+				oldAddressing := expandVirtualServerNetworkAddressing(old.([]interface{}))
+				newAddressing := expandVirtualServerNetworkAddressing(newNetworks.([]interface{}))
 
 				if len(oldNets) > len(newNets) {
 					// some network has been deleted
@@ -99,6 +101,21 @@ The virtual_server resource allows you to configure and run virtual machines.
 							log.Fatalf("[ERROR] unable to force new '%s': %v", key, err)
 						}
 					}
+
+					if newAddressing[i].IPv4Count != oldAddressing[i].IPv4Count {
+						key := fmt.Sprintf("network.%d.ipv4_count", i)
+						if err := d.ForceNew(key); err != nil {
+							log.Fatalf("[ERROR] unable to force new '%s': %v", key, err)
+						}
+					}
+
+					if newAddressing[i].IPv6Count != oldAddressing[i].IPv6Count {
+						key := fmt.Sprintf("network.%d.ipv6_count", i)
+						if err := d.ForceNew(key); err != nil {
+							log.Fatalf("[ERROR] unable to force new '%s': %v", key, err)
+						}
+					}
+
 					if len(newNet.IPs) < len(oldNets[i].IPs) {
 						// IPs are missing
 						key := fmt.Sprintf("network.%d.ips", i)
@@ -160,33 +177,11 @@ func resourceVirtualServerCreate(ctx context.Context, d *schema.ResourceData, m
 
 	provContext := m.(providerContext)
 	vsphereAPI := vsphere.NewAPI(provContext.legacyClient)
-	addressAPI := address.NewAPI(provContext.legacyClient)
 	locationID := d.Get("location_id").(string)
 
 	networks = expandVirtualServerNetworks(d.Get("network").([]interface{}))
-	for i, n := range networks {
-		if len(n.IPs) > 0 {
-			continue
-		}
-
-		res, err := addressAPI.ReserveRandom(ctx, address.ReserveRandom{
-			LocationID: locationID,
-			VlanID:     n.VLAN,
-			Count:      1,
-		})
-		if err != nil {
-			diags = append(diags, diag.FromErr(err)...)
-		} else if len(res.Data) > 0 {
-			networks[i].IPs = append(networks[i].IPs, res.Data[0].Address)
-		} else {
-			diags = append(diags, diag.Diagnostic{
-				Severity:      diag.Error,
-				Summary:       "Free IP not found",
-				Detail:        fmt.Sprintf("Free IP not found for VLAN: '%s'", n.VLAN),
-				AttributePath: cty.Path{cty.GetAttrStep{Name: "ips"}},
-			})
-		}
-	}
+	addressing := expandVirtualServerNetworkAddressing(d.Get("network").([]interface{}))
+	diags = append(diags, reserveVirtualServerAddresses(ctx, provContext, locationID, networks, addressing, true)...)
 
 	dns := expandVirtualServerDNS(d.Get("dns").([]interface{}))
 	if len(dns) != maxDNSEntries {
@@ -351,7 +346,9 @@ func resourceVirtualServerRead(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	specNetworks := expandVirtualServerNetworks(d.Get("network").([]interface{}))
+	specAddressing := expandVirtualServerNetworkAddressing(d.Get("network").([]interface{}))
 	networks := make([]vm.Network, 0, len(info.Network))
+	addressing := make([]networkAddressing, 0, len(info.Network))
 	for i, net := range info.Network {
 		if len(nicTypes) < net.NIC {
 			diags = append(diags, diag.Diagnostic{
@@ -393,10 +390,16 @@ func resourceVirtualServerRead(ctx context.Context, d *schema.ResourceData, m in
 			}
 
 			networks = append(networks, network)
+			addressing = append(addressing, networkAddressing{
+				IPv4Count: specAddressing[i].IPv4Count,
+				IPv6Count: specAddressing[i].IPv6Count,
+				IPv4:      net.IPv4,
+				IPv6:      net.IPv6,
+			})
 		}
 	}
 
-	flattenedNetworks := flattenVirtualServerNetwork(networks)
+	flattenedNetworks := flattenVirtualServerNetwork(networks, addressing)
 	if err = d.Set("network", flattenedNetworks); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
@@ -435,10 +438,15 @@ func resourceVirtualServerUpdate(ctx context.Context, d *schema.ResourceData, m
 		old, new := d.GetChange("network")
 		oldNets := expandVirtualServerNetworks(old.([]interface{}))
 		newNets := expandVirtualServerNetworks(new.([]interface{}))
+		newAddressing := expandVirtualServerNetworkAddressing(new.([]interface{}))
 
 		// We would want to check here as well that nothing else but `bandwidth_limit` got changed on each network to support partially updating it.
 		if len(oldNets) < len(newNets) {
 			ch.AddNICs = newNets[len(oldNets):]
+			locationID := d.Get("location_id").(string)
+			if diags := reserveVirtualServerAddresses(ctx, provContext, locationID, ch.AddNICs, newAddressing[len(oldNets):], false); diags.HasError() {
+				return diags
+			}
 		} else {
 			return diag.Errorf(
 				"unsupported update operation, cannot remove network or update its parameters",
@@ -491,6 +499,63 @@ func resourceVirtualServerUpdate(ctx context.Context, d *schema.ResourceData, m
 	return resourceVirtualServerRead(ctx, d, m)
 }
 
+// reserveVirtualServerAddresses reserves the number of IPv4 and IPv6 addresses requested for each network
+// and adds them to the network's IPs. When reserveDefault is set, a single address of any version is reserved
+// for networks without any IPs and address counts, which is what the engine would do otherwise.
+func reserveVirtualServerAddresses(ctx context.Context, pc providerContext, locationID string, networks []vm.Network, addressing []networkAddressing, reserveDefault bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, n := range networks {
+		requests := make([]address.ReserveRandom, 0, 2)
+
+		if addressing[i].IPv4Count > 0 {
+			requests = append(requests, address.ReserveRandom{
+				LocationID: locationID,
+				VlanID:     n.VLAN,
+				IPVersion:  address.IPReserveVersionLimit(4),
+				Count:      addressing[i].IPv4Count,
+			})
+		}
+
+		if addressing[i].IPv6Count > 0 {
+			requests = append(requests, address.ReserveRandom{
+				LocationID: locationID,
+				VlanID:     n.VLAN,
+				IPVersion:  address.IPReserveVersionLimit(6),
+				Count:      addressing[i].IPv6Count,
+			})
+		}
+
+		if len(requests) == 0 && len(n.IPs) == 0 && reserveDefault {
+			requests = append(requests, address.ReserveRandom{
+				LocationID: locationID,
+				VlanID:     n.VLAN,
+				Count:      1,
+			})
+		}
+
+		for _, req := range requests {
+			// reservations of concurrently created VMs in the same VLAN are batched
+			res, err := reserveRandomAddresses(ctx, pc, req)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Unable to reserve IP",
+					Detail:        fmt.Sprintf("Unable to reserve IP for VLAN '%s': %s", n.VLAN, err),
+					AttributePath: cty.Path{cty.GetAttrStep{Name: "ips"}},
+				})
+				continue
+			}
+
+			for _, addr := range res {
+				networks[i].IPs = append(networks[i].IPs, addr.Address)
+			}
+		}
+	}
+
+	return diags
+}
+
 func resourceVirtualServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(providerContext).legacyClient
 	vsphereAPI := vsphere.NewAPI(c)
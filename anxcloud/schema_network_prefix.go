@@ -2,6 +2,7 @@ package anxcloud
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func schemaNetworkPrefix() map[string]*schema.Schema {
@@ -33,13 +34,13 @@ func schemaNetworkPrefix() map[string]*schema.Schema {
 		"vlan_id": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "The corresponding VLAN identifier",
+			Description: "The corresponding VLAN identifier. Can be changed to assign the prefix to another VLAN, or removed to unassign it.",
 		},
 		"router_redundancy": {
 			Type:        schema.TypeBool,
 			Optional:    true,
 			Default:     false,
-			Description: "If router Redundancy shall be enabled.",
+			Description: "If router Redundancy shall be enabled. Can be toggled without replacing the prefix.",
 		},
 		"description_customer": {
 			Type:        schema.TypeString,
@@ -77,6 +78,122 @@ func schemaNetworkPrefix() map[string]*schema.Schema {
 			Computed:    true,
 			Description: "Internal description.",
 		},
+		"allow_replace": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+			Description: "Changes to `location_id`, `netmask`, `ip_version` or `type` require the prefix to be replaced, " +
+				"which releases all of its addresses. Such changes are refused unless this is set to `true`.",
+		},
 		"locations": schemaLocations(),
 	}
 }
+
+func schemaNetworkPrefixes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"location_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only list prefixes at the location with this identifier.",
+		},
+		"vlan_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only list prefixes assigned to the VLAN with this identifier.",
+		},
+		"ip_version": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Only list prefixes of this version: 4 = IPv4, 6 = IPv6.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only list prefixes with this status, e.g. `Active`.",
+		},
+		"prefixes": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "List of available network prefixes.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"identifier": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: identifierDescription,
+					},
+					"cidr": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "CIDR of the prefix.",
+					},
+					"ip_version": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The Prefix version: 4 = IPv4, 6 = IPv6.",
+					},
+					"netmask": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Netmask size.",
+					},
+					"type": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The Prefix type: 0 = Public, 1 = Private.",
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Status of the prefix.",
+					},
+					"location_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Identifier of the location of the prefix.",
+					},
+					"vlan_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The corresponding VLAN identifier.",
+					},
+					"description_customer": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Additional customer description.",
+					},
+					"role_text": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Role of the prefix.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaFreeIPAddresses() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"network_prefix_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Identifier of the network prefix to search free addresses in.",
+		},
+		"count": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Number of free addresses to return. Defaults to 1.",
+		},
+		"addresses": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "List of free addresses in ascending order.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
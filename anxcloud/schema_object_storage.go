@@ -213,23 +213,7 @@ func setObjectStorageCommonFieldsFromAPI(obj interface{}, d *schema.ResourceData
 
 // setObjectStorageStateFieldFromAPI sets the state field from API response to resource data
 func setObjectStorageStateFieldFromAPI(obj interface{}, d *schema.ResourceData, diags *diag.Diagnostics) {
-	var state *objectstoragev2.GenericAttributeState
-	switch o := obj.(type) {
-	case *objectstoragev2.Endpoint:
-		state = o.State
-	case *objectstoragev2.S3Backend:
-		state = o.State
-	case *objectstoragev2.Tenant:
-		state = o.State
-	case *objectstoragev2.Bucket:
-		state = o.State
-	case *objectstoragev2.User:
-		state = o.State
-	case *objectstoragev2.Key:
-		state = o.State
-	case *objectstoragev2.Region:
-		state = o.State
-	}
+	state := objectStorageState(obj)
 
 	if state != nil {
 		if err := d.Set("state", state.ID); err != nil {
@@ -2,6 +2,7 @@ package anxcloud
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func schemaTags() map[string]*schema.Schema {
@@ -62,21 +63,44 @@ func schemaTag() map[string]*schema.Schema {
 			Description: "The tag name.",
 		},
 		"service_id": {
-			Type:        schema.TypeString,
-			Required:    true,
-			ForceNew:    true,
-			Description: "The identifier of the service this tag should be assigned to.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"service_id", "assignment"},
+			Deprecated:   "Use an `assignment` block instead.",
+			Description:  "The identifier of the service this tag should be assigned to.",
 		},
 		"customer_id": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			ForceNew:    true,
-			Description: "The identifier of the customer this tag should be assigned to. Leave empty to assign to the organization of the logged in user.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"assignment"},
+			Deprecated:    "Use an `assignment` block instead.",
+			Description:   "The identifier of the customer this tag should be assigned to. Leave empty to assign to the organization of the logged in user. Changing it replaces the tag.",
+		},
+		"assignment": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Description: "Assignments of the tag to services and organisations. Assignments are added and removed without replacing the tag, assignments made outside of Terraform are kept." +
+				" Assignments are removed by service, so changing the customer of an assignment removes the tag from that service, including assignments made outside of Terraform, and assigns it again. The tag is replaced if all of its assignments belong to that service.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"service_id": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The identifier of the service this tag should be assigned to.",
+					},
+					"customer_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The identifier of the customer this tag should be assigned to. Leave empty to assign to the organization of the logged in user.",
+					},
+				},
+			},
 		},
 		"organisation_assignments": {
 			Type:        schema.TypeList,
 			Computed:    true,
-			Description: "Organisation assignments.",
+			Description: "All organisation assignments of the tag, including those made outside of Terraform.",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"customer": {
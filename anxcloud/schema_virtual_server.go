@@ -2,6 +2,7 @@ package anxcloud
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func schemaVirtualServer() map[string]*schema.Schema {
@@ -131,7 +132,39 @@ func schemaVirtualServer() map[string]*schema.Schema {
 						Optional: true,
 						ForceNew: true,
 						Description: "Requested set of IPs and IPs identifiers. IPs are ignored when using template_type 'from_scratch'. " +
-							"Defaults to free IPs from IP pool attached to VLAN.",
+							"Defaults to a single free IP from IP pool attached to VLAN if neither `ipv4_count` nor `ipv6_count` are set.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"ipv4_count": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description: "Number of IPv4 addresses to reserve automatically from the prefixes attached to the VLAN. " +
+							"Reserved addresses are added to the ones given in `ips`.",
+					},
+					"ipv6_count": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description: "Number of IPv6 addresses to reserve automatically from the prefixes attached to the VLAN. " +
+							"Reserved addresses are added to the ones given in `ips`.",
+					},
+					"ipv4": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "List of IPv4 addresses attached to the network interface.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"ipv6": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "List of IPv6 addresses attached to the network interface.",
 						Elem: &schema.Schema{
 							Type: schema.TypeString,
 						},
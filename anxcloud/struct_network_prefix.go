@@ -0,0 +1,40 @@
+package anxcloud
+
+import (
+	"go.anx.io/go-anxcloud/pkg/ipam/prefix"
+)
+
+// expanders
+
+// flatteners
+
+func flattenNetworkPrefixes(in []prefix.Info) []interface{} {
+	att := []interface{}{}
+	if len(in) < 1 {
+		return att
+	}
+
+	for _, p := range in {
+		m := map[string]interface{}{}
+
+		m["identifier"] = p.ID
+		m["cidr"] = p.Name
+		m["ip_version"] = p.IPVersion
+		m["netmask"] = p.NetworkMask
+		m["type"] = p.PrefixType
+		m["status"] = p.Status
+		m["description_customer"] = p.CustomerDescription
+		m["role_text"] = p.Role
+
+		if len(p.Locations) > 0 {
+			m["location_id"] = p.Locations[0].ID
+		}
+		if len(p.Vlans) > 0 {
+			m["vlan_id"] = p.Vlans[0].ID
+		}
+
+		att = append(att, m)
+	}
+
+	return att
+}
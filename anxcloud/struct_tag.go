@@ -2,6 +2,8 @@ package anxcloud
 
 import (
 	"go.anx.io/go-anxcloud/pkg/core/tags"
+
+	corev1internal "github.com/anexia-it/terraform-provider-anxcloud/anxcloud/internal/apis/core/v1"
 )
 
 // flatteners
@@ -26,7 +28,7 @@ func flattenTags(in []tags.Summary) []interface{} {
 
 }
 
-func flattenOrganisationAssignments(in []tags.Organisation) []interface{} {
+func flattenOrganisationAssignments(in []corev1internal.TagOrganisation) []interface{} {
 	att := []interface{}{}
 	if len(in) < 1 {
 		return att
@@ -40,6 +40,32 @@ func expandVirtualServerNetworks(p []interface{}) []vm.Network {
 	return networks
 }
 
+// networkAddressing holds the addressing settings and state of a network interface
+// which aren't part of vm.Network.
+type networkAddressing struct {
+	IPv4Count int
+	IPv6Count int
+	IPv4      []string
+	IPv6      []string
+}
+
+func expandVirtualServerNetworkAddressing(p []interface{}) []networkAddressing {
+	addressing := make([]networkAddressing, len(p))
+
+	for i, elem := range p {
+		in := elem.(map[string]interface{})
+
+		if v, ok := in["ipv4_count"]; ok {
+			addressing[i].IPv4Count = v.(int)
+		}
+		if v, ok := in["ipv6_count"]; ok {
+			addressing[i].IPv6Count = v.(int)
+		}
+	}
+
+	return addressing
+}
+
 func expandVirtualServerDisks(p []interface{}) []Disk {
 	disks := make([]Disk, len(p))
 
@@ -222,18 +248,24 @@ func expandVirtualServerInfo(p []interface{}) info.Info {
 
 // flatteners
 
-func flattenVirtualServerNetwork(in []vm.Network) []interface{} {
+func flattenVirtualServerNetwork(in []vm.Network, addressing []networkAddressing) []interface{} {
 	att := []interface{}{}
 	if len(in) < 1 {
 		return att
 	}
 
-	for _, n := range in {
+	for i, n := range in {
 		net := map[string]interface{}{}
 		net["vlan_id"] = n.VLAN
 		net["nic_type"] = n.NICType
 		net["ips"] = n.IPs
 		net["bandwidth_limit"] = n.BandwidthLimit
+		if len(addressing) > i {
+			net["ipv4_count"] = addressing[i].IPv4Count
+			net["ipv6_count"] = addressing[i].IPv6Count
+			net["ipv4"] = addressing[i].IPv4
+			net["ipv6"] = addressing[i].IPv6
+		}
 		att = append(att, net)
 	}
 
@@ -280,6 +280,7 @@ func TestExpanderVirtualServerInfo(t *testing.T) {
 func TestFlattenVirtualServerNetwork(t *testing.T) {
 	cases := []struct {
 		Input          []vm.Network
+		Addressing     []networkAddressing
 		ExpectedOutput []interface{}
 	}{
 		{
@@ -296,6 +297,7 @@ func TestFlattenVirtualServerNetwork(t *testing.T) {
 					},
 				},
 			},
+			nil,
 			[]interface{}{
 				map[string]interface{}{
 					"vlan_id":         "38f8561acfe34qc49c336d2af31a5cc3",
@@ -310,10 +312,39 @@ func TestFlattenVirtualServerNetwork(t *testing.T) {
 				},
 			},
 		},
+		{
+			[]vm.Network{
+				{
+					VLAN:           "38f8561acfe34qc49c336d2af31a5cc3",
+					NICType:        "vmxnet3",
+					BandwidthLimit: 1000,
+				},
+			},
+			[]networkAddressing{
+				{
+					IPv4Count: 1,
+					IPv6Count: 2,
+					IPv4:      []string{"10.11.12.13"},
+					IPv6:      []string{"2001:db8::2", "2001:db8::3"},
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"vlan_id":         "38f8561acfe34qc49c336d2af31a5cc3",
+					"nic_type":        "vmxnet3",
+					"bandwidth_limit": 1000,
+					"ips":             []string(nil),
+					"ipv4_count":      1,
+					"ipv6_count":      2,
+					"ipv4":            []string{"10.11.12.13"},
+					"ipv6":            []string{"2001:db8::2", "2001:db8::3"},
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
-		output := flattenVirtualServerNetwork(tc.Input)
+		output := flattenVirtualServerNetwork(tc.Input, tc.Addressing)
 		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
 			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
 		}
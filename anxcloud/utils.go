@@ -95,6 +95,10 @@ outer:
 	return out
 }
 
+func sliceIntersect[T comparable](a, b []T) []T {
+	return sliceSubstract(a, sliceSubstract(a, b))
+}
+
 func mustCastInterfaceArray[T any](in []interface{}) []T {
 	out := make([]T, 0, len(in))
 	for _, v := range in {
@@ -97,3 +97,28 @@ func TestSliceSubstract(t *testing.T) {
 		}
 	}
 }
+
+func TestSliceIntersect(t *testing.T) {
+	a := []string{"a", "b", "c", "d", "e"}
+	b := []string{"d", "e", "f", "g", "h"}
+	c := []string{}
+
+	type testCase struct {
+		actual   []string
+		expected []string
+	}
+
+	testCases := []testCase{
+		{sliceIntersect(a, b), []string{"d", "e"}},
+		{sliceIntersect(b, a), []string{"d", "e"}},
+		{sliceIntersect(a, c), c},
+		{sliceIntersect(a, a), a},
+		{sliceIntersect(c, a), c},
+	}
+
+	for _, testCase := range testCases {
+		if diff := cmp.Diff(testCase.actual, testCase.expected); diff != "" {
+			t.Errorf("(-expected +actual):\n%s", diff)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+data "anxcloud_e5e_application" "example" {
+  name = "example application"
+}
@@ -0,0 +1,3 @@
+data "anxcloud_e5e_applications" "example" {
+  name_filter = "example"
+}
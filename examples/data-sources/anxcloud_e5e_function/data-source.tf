@@ -0,0 +1,8 @@
+data "anxcloud_e5e_application" "example" {
+  name = "example application"
+}
+
+data "anxcloud_e5e_function" "example" {
+  name        = "example function"
+  application = data.anxcloud_e5e_application.example.id
+}
@@ -0,0 +1,7 @@
+data "anxcloud_e5e_function_deployments" "example" {
+  function_id = anxcloud_e5e_function.example.id
+}
+
+output "last_deployment_state" {
+  value = data.anxcloud_e5e_function_deployments.example.deployments[0].state
+}
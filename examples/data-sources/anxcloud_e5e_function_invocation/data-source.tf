@@ -0,0 +1,14 @@
+# run a smoke test against the deployed function
+data "anxcloud_e5e_function_invocation" "smoke_test" {
+  function_id = anxcloud_e5e_function.example.id
+
+  payload = jsonencode({
+    check = "health"
+  })
+
+  expect_status = 200
+}
+
+output "smoke_test_response" {
+  value = jsondecode(data.anxcloud_e5e_function_invocation.smoke_test.response_body)
+}
@@ -0,0 +1,4 @@
+data "anxcloud_e5e_functions" "example" {
+  application = "<application-id>"
+  name_filter = "example"
+}
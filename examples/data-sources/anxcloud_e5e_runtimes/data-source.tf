@@ -0,0 +1,5 @@
+data "anxcloud_e5e_runtimes" "example" {}
+
+output "runtimes" {
+  value = data.anxcloud_e5e_runtimes.example.runtimes[*].id
+}
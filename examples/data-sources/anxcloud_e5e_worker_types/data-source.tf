@@ -0,0 +1,8 @@
+data "anxcloud_e5e_worker_types" "example" {}
+
+output "worker_types" {
+  value = {
+    for worker_type in data.anxcloud_e5e_worker_types.example.worker_types :
+    worker_type.id => worker_type.max_quota_memory
+  }
+}
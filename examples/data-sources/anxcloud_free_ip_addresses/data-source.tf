@@ -0,0 +1,11 @@
+data "anxcloud_free_ip_addresses" "example" {
+  network_prefix_id = data.anxcloud_network_prefix.example.id
+  count             = 2
+}
+
+resource "anxcloud_ip_address" "appliance" {
+  count = 2
+
+  network_prefix_id = data.anxcloud_network_prefix.example.id
+  address           = data.anxcloud_free_ip_addresses.example.addresses[count.index]
+}
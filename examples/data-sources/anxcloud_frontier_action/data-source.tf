@@ -0,0 +1,4 @@
+data "anxcloud_frontier_action" "example" {
+  endpoint            = data.anxcloud_frontier_endpoint.example.id
+  http_request_method = "get"
+}
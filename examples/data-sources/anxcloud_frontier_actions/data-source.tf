@@ -0,0 +1,3 @@
+data "anxcloud_frontier_actions" "example" {
+  endpoint = data.anxcloud_frontier_endpoint.example.id
+}
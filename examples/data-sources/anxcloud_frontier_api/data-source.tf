@@ -0,0 +1,3 @@
+data "anxcloud_frontier_api" "example" {
+  name = "example-api"
+}
@@ -0,0 +1,3 @@
+data "anxcloud_frontier_apis" "example" {
+  name_filter = "example"
+}
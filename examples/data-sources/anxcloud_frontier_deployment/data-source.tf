@@ -0,0 +1,8 @@
+data "anxcloud_frontier_deployment" "example" {
+  api  = data.anxcloud_frontier_api.example.id
+  slug = "v1"
+}
+
+output "pets_url" {
+  value = data.anxcloud_frontier_deployment.example.endpoint_urls["/pets"]
+}
@@ -0,0 +1,7 @@
+data "anxcloud_frontier_deployments" "example" {
+  api = data.anxcloud_frontier_api.example.id
+}
+
+output "deployment_urls" {
+  value = data.anxcloud_frontier_deployments.example.deployments[*].url
+}
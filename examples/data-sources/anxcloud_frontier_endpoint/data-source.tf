@@ -0,0 +1,4 @@
+data "anxcloud_frontier_endpoint" "example" {
+  api  = data.anxcloud_frontier_api.example.id
+  path = "/pets"
+}
@@ -0,0 +1,3 @@
+data "anxcloud_frontier_endpoints" "example" {
+  api = data.anxcloud_frontier_api.example.id
+}
@@ -0,0 +1,3 @@
+data "anxcloud_network_prefix" "by_cidr" {
+  cidr = "10.244.2.0/24"
+}
@@ -0,0 +1,5 @@
+data "anxcloud_network_prefixes" "example" {
+  vlan_id    = "<vlan-id>"
+  ip_version = 4
+  status     = "Active"
+}
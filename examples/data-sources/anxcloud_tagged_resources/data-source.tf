@@ -0,0 +1,8 @@
+data "anxcloud_tagged_resources" "ingress" {
+  tags  = ["role=ingress", "production"]
+  match = "all"
+}
+
+output "ingress_ids" {
+  value = data.anxcloud_tagged_resources.ingress.ids
+}
@@ -27,6 +27,15 @@ resource "anxcloud_e5e_function" "example" {
   #   name    = "function.zip"
   #   content = "data:application/zip;base64,${filebase64("${path.module}/function.zip")}"
   # }
+  #
+  # # the provider can also package a local directory and redeploy the function
+  # # whenever its contents change:
+  #
+  # storage_backend_archive {
+  #   source_dir = "${path.module}/src"
+  #   include    = ["**/*.py"]
+  #   exclude    = ["**/__pycache__", "**/test_*.py"]
+  # }
 
   # configure environment variables
   env {
@@ -35,14 +44,23 @@ resource "anxcloud_e5e_function" "example" {
   }
 
   # configure secret environment variables
-  # note: changes to secret environment variables outside the terraform state
-  # are not tracked
+  # note: the API returns secret environment variables without their value,
+  # changes made outside of terraform can't be detected
   env {
     name   = "EXAMPLE_SECRET"
     value  = "secret"
     secret = true
   }
 
+  # configure write-only secret environment variables (Terraform 1.11 and later),
+  # the value isn't stored in the state, increment value_wo_version to apply it again
+  env {
+    name             = "EXAMPLE_WRITE_ONLY_SECRET"
+    value_wo         = "secret"
+    value_wo_version = 1
+    secret           = true
+  }
+
   # configure hostnames
   hostname {
     hostname = "example.com"
@@ -1,6 +1,6 @@
 resource "anxcloud_frontier_deployment" "foo" {
   slug = "foo"
-  api = anxcloud_frontier_api.foo.id
+  api  = anxcloud_frontier_api.foo.id
 
   depends_on = [
     # make sure that all actions of the API have been created
@@ -10,7 +10,6 @@ resource "anxcloud_frontier_deployment" "foo" {
   # optional: handle automated redeployment (e.g. in CI)
   revision = var.commit_sha
 
-  lifecycle {
-    create_before_destroy = true
-  }
+  # optional: keep the previous deployment after a redeployment
+  retain_deployments = 1
 }
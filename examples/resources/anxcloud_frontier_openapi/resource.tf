@@ -0,0 +1,41 @@
+resource "anxcloud_frontier_api" "example" {
+  name              = "example-api"
+  transfer_protocol = "http"
+}
+
+resource "anxcloud_frontier_openapi" "example" {
+  api = anxcloud_frontier_api.example.id
+  spec = templatefile("${path.module}/openapi.yaml", {
+    function = anxcloud_e5e_function.example.id
+  })
+}
+
+# openapi.yaml
+#
+# openapi: 3.0.3
+# info:
+#   title: Example
+#   version: 1.0.0
+# paths:
+#   /pets/{id}:
+#     summary: Pet
+#     get:
+#       x-frontier-action:
+#         e5e_function:
+#           function: ${function}
+#   /health:
+#     get:
+#       x-frontier-action:
+#         mock_response:
+#           body: ok
+#           language: plaintext
+
+resource "anxcloud_frontier_deployment" "example" {
+  api      = anxcloud_frontier_api.example.id
+  slug     = "v1"
+  revision = anxcloud_frontier_openapi.example.fingerprint
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
@@ -0,0 +1,16 @@
+resource "anxcloud_e5e_application" "example" {
+  name = "example-application"
+}
+
+# attaches the tags to the application, other tags of the application are kept
+resource "anxcloud_resource_tags" "cost_allocation" {
+  resource_id = anxcloud_e5e_application.example.id
+  tags        = ["cost-center-4711", "team-platform"]
+}
+
+# removes all tags of the resource except the configured ones
+resource "anxcloud_resource_tags" "exclusive" {
+  resource_id   = "<resource identifier>"
+  tags          = ["managed-by-terraform"]
+  authoritative = true
+}
@@ -1,4 +1,12 @@
 resource "anxcloud_tag" "example" {
-  name       = "tag-name"
-  service_id = "<SERVICE_ID>"
-}
\ No newline at end of file
+  name = "tag-name"
+
+  assignment {
+    service_id = "<SERVICE_ID>"
+  }
+
+  assignment {
+    service_id  = "<OTHER_SERVICE_ID>"
+    customer_id = "<CUSTOMER_ID>"
+  }
+}
@@ -23,7 +23,9 @@ type AnexiaProvider struct {
 }
 
 type AnexiaProviderModel struct {
-	Token types.String `tfsdk:"token"`
+	Token               types.String `tfsdk:"token"`
+	DNSBatchWaitSeconds types.Int64  `tfsdk:"dns_batch_wait_seconds"`
+	DNSBatchMaxSize     types.Int64  `tfsdk:"dns_batch_max_size"`
 }
 
 func (p *AnexiaProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -39,6 +41,16 @@ func (p *AnexiaProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Description: "Anexia Cloud token.",
 				Sensitive:   true,
 			},
+			// DNS batching is implemented by the SDKv2 provider, these attributes only
+			// exist to keep the schemas of both muxed providers identical
+			"dns_batch_wait_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of seconds DNS record create and delete operations of the same zone are collected before they are sent to the API as a single change set. Defaults to 15 seconds.",
+			},
+			"dns_batch_max_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of DNS record operations sent as a single change set. A batch is sent early once it reached this size. Defaults to 0, which means unlimited.",
+			},
 		},
 	}
 }